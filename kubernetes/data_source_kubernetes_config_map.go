@@ -0,0 +1,31 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesConfigMap() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesConfigMapRead,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("config map", false),
+			"data": {
+				Type:        schema.TypeMap,
+				Description: "A map of the configuration data.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesConfigMapRead(d *schema.ResourceData, meta interface{}) error {
+	om := meta_v1.ObjectMeta{
+		Namespace: namespaceOrDefault(d.Get("metadata.0.namespace").(string), meta.(*providerMeta).metadata),
+		Name:      d.Get("metadata.0.name").(string),
+	}
+	d.SetId(buildId(om))
+
+	return resourceKubernetesConfigMapRead(d, dataSourceMeta(meta))
+}
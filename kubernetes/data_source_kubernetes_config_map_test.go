@@ -0,0 +1,74 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDataSourceKubernetesConfigMapRead(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/api/v1/namespaces/kube-system/configmaps/tf-test": &api.ConfigMap{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:        "tf-test",
+				Namespace:   "kube-system",
+				Annotations: map[string]string{"owner": "tf-test"},
+			},
+			Data: map[string]string{"one": "first", "two": "second"},
+		},
+	})
+	defer closeFn()
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesConfigMap().Schema, map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{"name": "tf-test", "namespace": "kube-system"},
+		},
+	})
+	if err := dataSourceKubernetesConfigMapRead(d, testProviderMeta(conn)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	checkResourceDataValues(t, d, map[string]interface{}{
+		"id":                           "kube-system/tf-test",
+		"metadata.0.annotations.owner": "tf-test",
+		"data": map[string]interface{}{
+			"one": "first",
+			"two": "second",
+		},
+	})
+}
+
+func TestDataSourceKubernetesConfigMapRead_defaultLabels(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/api/v1/namespaces/default/configmaps/tf-test": &api.ConfigMap{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "tf-test",
+				Namespace: "default",
+				Labels:    map[string]string{"app": "web", "managed-by": "terraform"},
+			},
+		},
+	})
+	defer closeFn()
+	meta := testProviderMeta(conn)
+	meta.metadata.defaultLabels = map[string]string{"managed-by": "terraform"}
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesConfigMap().Schema, map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{"name": "tf-test"},
+		},
+	})
+	if err := dataSourceKubernetesConfigMapRead(d, meta); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// Data sources report the labels equal to their default too
+	checkResourceDataValues(t, d, map[string]interface{}{
+		"metadata.0.labels": map[string]interface{}{
+			"app":        "web",
+			"managed-by": "terraform",
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesDeployment() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesDeploymentRead,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("deployment", false),
+			"spec":     computedSchema(resourceKubernetesDeployment().Schema["spec"]),
+		},
+	}
+}
+
+func dataSourceKubernetesDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	om := meta_v1.ObjectMeta{
+		Namespace: namespaceOrDefault(d.Get("metadata.0.namespace").(string), meta.(*providerMeta).metadata),
+		Name:      d.Get("metadata.0.name").(string),
+	}
+	d.SetId(buildId(om))
+
+	return resourceKubernetesDeploymentRead(d, dataSourceMeta(meta))
+}
@@ -0,0 +1,52 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	apps "k8s.io/api/apps/v1"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDataSourceKubernetesDeploymentRead(t *testing.T) {
+	labels := map[string]string{"app": "tf-test"}
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/apis/apps/v1/namespaces/default/deployments/tf-test": &apps.Deployment{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "tf-test", Namespace: "default"},
+			Spec: apps.DeploymentSpec{
+				Replicas: ptrToInt32(3),
+				Selector: &meta_v1.LabelSelector{MatchLabels: labels},
+				Template: api.PodTemplateSpec{
+					ObjectMeta: meta_v1.ObjectMeta{Labels: labels},
+					Spec: api.PodSpec{
+						Containers: []api.Container{
+							{Name: "web", Image: "nginx:1.15"},
+						},
+					},
+				},
+				Strategy: apps.DeploymentStrategy{Type: apps.RecreateDeploymentStrategyType},
+			},
+		},
+	})
+	defer closeFn()
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesDeployment().Schema, map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{"name": "tf-test"},
+		},
+	})
+	if err := dataSourceKubernetesDeploymentRead(d, testProviderMeta(conn)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	checkResourceDataValues(t, d, map[string]interface{}{
+		"id":                                 "default/tf-test",
+		"spec.0.replicas":                    3,
+		"spec.0.selector.0.match_labels.app": "tf-test",
+		"spec.0.deployment_strategy.0.type":  "Recreate",
+		"spec.0.template.0.spec.0.container.0.name":  "web",
+		"spec.0.template.0.spec.0.container.0.image": "nginx:1.15",
+	})
+}
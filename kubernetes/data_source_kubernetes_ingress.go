@@ -0,0 +1,27 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesIngress() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesIngressRead,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("ingress", false),
+			"spec":     computedSchema(resourceKubernetesIngress().Schema["spec"]),
+		},
+	}
+}
+
+func dataSourceKubernetesIngressRead(d *schema.ResourceData, meta interface{}) error {
+	om := meta_v1.ObjectMeta{
+		Namespace: namespaceOrDefault(d.Get("metadata.0.namespace").(string), meta.(*providerMeta).metadata),
+		Name:      d.Get("metadata.0.name").(string),
+	}
+	d.SetId(buildId(om))
+
+	return resourceKubernetesIngressRead(d, dataSourceMeta(meta))
+}
@@ -0,0 +1,69 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/extensions/v1beta1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestDataSourceKubernetesIngressRead(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/apis/extensions/v1beta1/namespaces/default/ingresses/tf-test": &api.Ingress{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "tf-test", Namespace: "default"},
+			Spec: api.IngressSpec{
+				Backend: &api.IngressBackend{
+					ServiceName: "default-backend",
+					ServicePort: intstr.FromInt(80),
+				},
+				TLS: []api.IngressTLS{
+					{Hosts: []string{"example.com"}, SecretName: "example-tls"},
+				},
+				Rules: []api.IngressRule{
+					{
+						Host: "example.com",
+						IngressRuleValue: api.IngressRuleValue{
+							HTTP: &api.HTTPIngressRuleValue{
+								Paths: []api.HTTPIngressPath{
+									{
+										Path: "/app",
+										Backend: api.IngressBackend{
+											ServiceName: "app",
+											ServicePort: intstr.FromString("http"),
+										},
+									},
+								},
+							},
+						},
+					},
+					{Host: "static.example.com"},
+				},
+			},
+		},
+	})
+	defer closeFn()
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesIngress().Schema, map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{"name": "tf-test"},
+		},
+	})
+	if err := dataSourceKubernetesIngressRead(d, testProviderMeta(conn)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	checkResourceDataValues(t, d, map[string]interface{}{
+		"id":                                 "default/tf-test",
+		"spec.0.backend.0.service_name":      "default-backend",
+		"spec.0.backend.0.service_port":      "80",
+		"spec.0.tls.0.secret_name":           "example-tls",
+		"spec.0.rules.0.host":                "example.com",
+		"spec.0.rules.0.http.0.paths.0.path": "/app",
+		"spec.0.rules.0.http.0.paths.0.backend.0.service_name": "app",
+		"spec.0.rules.0.http.0.paths.0.backend.0.service_port": "http",
+		"spec.0.rules.1.host": "static.example.com",
+	})
+}
@@ -0,0 +1,22 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceKubernetesNamespace() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesNamespaceRead,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("namespace", false),
+		},
+	}
+}
+
+func dataSourceKubernetesNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("metadata.0.name").(string)
+	d.SetId(name)
+
+	return resourceKubernetesNamespaceRead(d, dataSourceMeta(meta))
+}
@@ -0,0 +1,40 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDataSourceKubernetesNamespaceRead(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/api/v1/namespaces/tf-test": &api.Namespace{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:            "tf-test",
+				Labels:          map[string]string{"team": "platform"},
+				ResourceVersion: "42",
+				UID:             "8f1c7f0e-4b0a-11e8-9b3d-42010a800002",
+			},
+		},
+	})
+	defer closeFn()
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesNamespace().Schema, map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{"name": "tf-test"},
+		},
+	})
+	if err := dataSourceKubernetesNamespaceRead(d, testProviderMeta(conn)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	checkResourceDataValues(t, d, map[string]interface{}{
+		"id":                          "tf-test",
+		"metadata.0.labels.team":      "platform",
+		"metadata.0.resource_version": "42",
+		"metadata.0.uid":              "8f1c7f0e-4b0a-11e8-9b3d-42010a800002",
+	})
+}
@@ -0,0 +1,68 @@
+package kubernetes
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesNamespaces() *schema.Resource {
+	fields := listSelectorFields("namespaces")
+	fields["namespaces"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The namespaces matching the selectors.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metadata": computedSchema(metadataSchema("namespace", false)),
+				"phase": {
+					Type:        schema.TypeString,
+					Description: "The phase of the namespace: `Active` or `Terminating`.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceKubernetesNamespacesRead,
+		Schema: fields,
+	}
+}
+
+func dataSourceKubernetesNamespacesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+	config := dataSourceMeta(meta).metadata
+
+	opts := expandListOptions(d)
+
+	namespaces := make([]interface{}, 0)
+	for {
+		log.Printf("[INFO] Listing namespaces: %s", redacted(opts))
+		out, err := conn.CoreV1().Namespaces().List(opts)
+		if err != nil {
+			log.Printf("[DEBUG] Received error: %s", redacted(err))
+			return err
+		}
+		for _, n := range out.Items {
+			namespaces = append(namespaces, flattenNamespaceSummary(n, config))
+		}
+		if out.Continue == "" {
+			break
+		}
+		opts.Continue = out.Continue
+	}
+	log.Printf("[INFO] Received %d namespaces", len(namespaces))
+
+	d.SetId(buildListId(meta_v1.NamespaceAll, opts))
+	return d.Set("namespaces", namespaces)
+}
+
+func flattenNamespaceSummary(in api.Namespace, config *metadataConfig) map[string]interface{} {
+	return map[string]interface{}{
+		"metadata": flattenMetadata(in.ObjectMeta, nil, config),
+		"phase":    string(in.Status.Phase),
+	}
+}
@@ -0,0 +1,70 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDataSourceKubernetesNamespacesRead(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/api/v1/namespaces?fieldSelector=status.phase%3DActive&labelSelector=team%3Dx&limit=500": &api.NamespaceList{
+			Items: []api.Namespace{
+				{
+					ObjectMeta: meta_v1.ObjectMeta{Name: "x-dev", Labels: map[string]string{"team": "x"}},
+					Status:     api.NamespaceStatus{Phase: api.NamespaceActive},
+				},
+				{
+					ObjectMeta: meta_v1.ObjectMeta{Name: "x-prod", Labels: map[string]string{"team": "x"}},
+					Status:     api.NamespaceStatus{Phase: api.NamespaceActive},
+				},
+			},
+		},
+	})
+	defer closeFn()
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesNamespaces().Schema, map[string]interface{}{
+		"label_selector": "team=x",
+		"field_selector": "status.phase=Active",
+	})
+	if err := dataSourceKubernetesNamespacesRead(d, testProviderMeta(conn)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	checkResourceDataValues(t, d, map[string]interface{}{
+		"id":                                  "/team=x/status.phase=Active",
+		"namespaces.#":                        2,
+		"namespaces.0.metadata.0.name":        "x-dev",
+		"namespaces.0.metadata.0.labels.team": "x",
+		"namespaces.0.phase":                  "Active",
+		"namespaces.1.metadata.0.name":        "x-prod",
+	})
+}
+
+func TestDataSourceKubernetesNamespacesRead_defaultLabels(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/api/v1/namespaces": &api.NamespaceList{
+			Items: []api.Namespace{
+				{ObjectMeta: meta_v1.ObjectMeta{Name: "x-dev", Labels: map[string]string{"team": "x"}}},
+			},
+		},
+	})
+	defer closeFn()
+	meta := testProviderMeta(conn)
+	meta.metadata.defaultLabels = map[string]string{"team": "x"}
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesNamespaces().Schema, map[string]interface{}{})
+	if err := dataSourceKubernetesNamespacesRead(d, meta); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	checkResourceDataValues(t, d, map[string]interface{}{
+		"namespaces.0.metadata.0.labels.team": "x",
+	})
+	if labels := meta.metadata.defaultLabels; len(labels) != 1 {
+		t.Fatalf("Expected the defaults of the provider to be left alone, given: %#v", labels)
+	}
+}
@@ -0,0 +1,171 @@
+package kubernetes
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesNodes() *schema.Resource {
+	fields := listSelectorFields("nodes")
+	fields["nodes"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The nodes matching the selectors.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metadata": computedSchema(metadataSchema("node", false)),
+				"allocatable": {
+					Type:        schema.TypeMap,
+					Description: "The resources of the node that are available for scheduling pods, i.e. the capacity minus the resources reserved for the system. More info: https://kubernetes.io/docs/tasks/administer-cluster/reserve-compute-resources/#node-allocatable",
+					Computed:    true,
+				},
+				"capacity": {
+					Type:        schema.TypeMap,
+					Description: "The total resources of the node.",
+					Computed:    true,
+				},
+				"external_ips": {
+					Type:        schema.TypeList,
+					Description: "The IP addresses of the node that are routable from outside the cluster.",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"internal_ips": {
+					Type:        schema.TypeList,
+					Description: "The IP addresses of the node that are only routable within the cluster.",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"kubelet_version": {
+					Type:        schema.TypeString,
+					Description: "The version of the kubelet running on the node.",
+					Computed:    true,
+				},
+				"labels": {
+					Type:        schema.TypeMap,
+					Description: "All the labels of the node, including the `kubernetes.io` ones left out of `metadata`, such as the zone or the instance type.",
+					Computed:    true,
+				},
+				"ready": {
+					Type:        schema.TypeBool,
+					Description: "Whether the node is healthy and ready to accept pods.",
+					Computed:    true,
+				},
+				"taint": {
+					Type:        schema.TypeList,
+					Description: "The taints of the node, pods without a matching toleration are repelled from it.",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"effect": {
+								Type:        schema.TypeString,
+								Description: "The effect of the taint on pods that don't tolerate it: `NoSchedule`, `PreferNoSchedule` or `NoExecute`.",
+								Computed:    true,
+							},
+							"key": {
+								Type:        schema.TypeString,
+								Description: "The taint key.",
+								Computed:    true,
+							},
+							"value": {
+								Type:        schema.TypeString,
+								Description: "The taint value.",
+								Computed:    true,
+							},
+						},
+					},
+				},
+				"unschedulable": {
+					Type:        schema.TypeBool,
+					Description: "Whether new pods can't be scheduled on the node, e.g. because it's cordoned.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceKubernetesNodesRead,
+		Schema: fields,
+	}
+}
+
+func dataSourceKubernetesNodesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+	config := dataSourceMeta(meta).metadata
+
+	opts := expandListOptions(d)
+
+	nodes := make([]interface{}, 0)
+	for {
+		log.Printf("[INFO] Listing nodes: %s", redacted(opts))
+		out, err := conn.CoreV1().Nodes().List(opts)
+		if err != nil {
+			log.Printf("[DEBUG] Received error: %s", redacted(err))
+			return err
+		}
+		for _, n := range out.Items {
+			nodes = append(nodes, flattenNodeSummary(n, config))
+		}
+		if out.Continue == "" {
+			break
+		}
+		opts.Continue = out.Continue
+	}
+	log.Printf("[INFO] Received %d nodes", len(nodes))
+
+	d.SetId(buildListId(meta_v1.NamespaceAll, opts))
+	return d.Set("nodes", nodes)
+}
+
+func flattenNodeSummary(in api.Node, config *metadataConfig) map[string]interface{} {
+	// flattenMetadata removes the kubernetes.io labels from the map
+	labels := make(map[string]string, len(in.Labels))
+	for k, v := range in.Labels {
+		labels[k] = v
+	}
+
+	att := map[string]interface{}{
+		"allocatable":     flattenResourceList(in.Status.Allocatable),
+		"capacity":        flattenResourceList(in.Status.Capacity),
+		"external_ips":    nodeAddresses(in.Status.Addresses, api.NodeExternalIP),
+		"internal_ips":    nodeAddresses(in.Status.Addresses, api.NodeInternalIP),
+		"kubelet_version": in.Status.NodeInfo.KubeletVersion,
+		"labels":          labels,
+		"metadata":        flattenMetadata(in.ObjectMeta, nil, config),
+		"ready":           false,
+		"taint":           flattenNodeTaints(in.Spec.Taints),
+		"unschedulable":   in.Spec.Unschedulable,
+	}
+	for _, c := range in.Status.Conditions {
+		if c.Type == api.NodeReady {
+			att["ready"] = c.Status == api.ConditionTrue
+		}
+	}
+	return att
+}
+
+func nodeAddresses(in []api.NodeAddress, addressType api.NodeAddressType) []string {
+	addresses := make([]string, 0)
+	for _, a := range in {
+		if a.Type == addressType {
+			addresses = append(addresses, a.Address)
+		}
+	}
+	return addresses
+}
+
+func flattenNodeTaints(in []api.Taint) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, t := range in {
+		att[i] = map[string]interface{}{
+			"effect": string(t.Effect),
+			"key":    t.Key,
+			"value":  t.Value,
+		}
+	}
+	return att
+}
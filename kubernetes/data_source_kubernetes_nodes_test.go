@@ -0,0 +1,96 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDataSourceKubernetesNodesRead(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/api/v1/nodes?limit=500": &api.NodeList{
+			Items: []api.Node{
+				{
+					ObjectMeta: meta_v1.ObjectMeta{
+						Name: "node-1",
+						Labels: map[string]string{
+							"pool":                                   "highmem",
+							"failure-domain.beta.kubernetes.io/zone": "europe-west1-b",
+						},
+					},
+					Spec: api.NodeSpec{
+						Taints: []api.Taint{
+							{Key: "dedicated", Value: "highmem", Effect: api.TaintEffectNoSchedule},
+						},
+					},
+					Status: api.NodeStatus{
+						Capacity: api.ResourceList{
+							api.ResourceCPU:    resource.MustParse("4"),
+							api.ResourceMemory: resource.MustParse("26Gi"),
+						},
+						Allocatable: api.ResourceList{
+							api.ResourceCPU:    resource.MustParse("3920m"),
+							api.ResourceMemory: resource.MustParse("25Gi"),
+						},
+						Addresses: []api.NodeAddress{
+							{Type: api.NodeInternalIP, Address: "10.132.0.2"},
+							{Type: api.NodeExternalIP, Address: "35.195.0.1"},
+							{Type: api.NodeHostName, Address: "node-1"},
+						},
+						Conditions: []api.NodeCondition{
+							{Type: api.NodeOutOfDisk, Status: api.ConditionFalse},
+							{Type: api.NodeReady, Status: api.ConditionTrue},
+						},
+						NodeInfo: api.NodeSystemInfo{KubeletVersion: "v1.10.2"},
+					},
+				},
+				{
+					ObjectMeta: meta_v1.ObjectMeta{Name: "node-2"},
+					Spec:       api.NodeSpec{Unschedulable: true},
+				},
+			},
+		},
+	})
+	defer closeFn()
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesNodes().Schema, map[string]interface{}{})
+	if err := dataSourceKubernetesNodesRead(d, testProviderMeta(conn)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	checkResourceDataValues(t, d, map[string]interface{}{
+		"nodes.#":                 2,
+		"nodes.0.metadata.0.name": "node-1",
+		"nodes.0.metadata.0.labels": map[string]interface{}{
+			"pool": "highmem",
+		},
+		"nodes.0.labels": map[string]interface{}{
+			"pool":                                   "highmem",
+			"failure-domain.beta.kubernetes.io/zone": "europe-west1-b",
+		},
+		"nodes.0.taint": []interface{}{
+			map[string]interface{}{"key": "dedicated", "value": "highmem", "effect": "NoSchedule"},
+		},
+		"nodes.0.capacity": map[string]interface{}{
+			"cpu":    "4",
+			"memory": "26Gi",
+		},
+		"nodes.0.allocatable": map[string]interface{}{
+			"cpu":    "3920m",
+			"memory": "25Gi",
+		},
+		"nodes.0.internal_ips":    []interface{}{"10.132.0.2"},
+		"nodes.0.external_ips":    []interface{}{"35.195.0.1"},
+		"nodes.0.kubelet_version": "v1.10.2",
+		"nodes.0.ready":           true,
+		"nodes.0.unschedulable":   false,
+		"nodes.1.metadata.0.name": "node-2",
+		"nodes.1.unschedulable":   true,
+		"nodes.1.ready":           false,
+		"nodes.1.taint":           []interface{}{},
+	})
+}
@@ -0,0 +1,27 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesPersistentVolumeClaim() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesPersistentVolumeClaimRead,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("persistent volume claim", false),
+			"spec":     computedSchema(resourceKubernetesPersistentVolumeClaim().Schema["spec"]),
+		},
+	}
+}
+
+func dataSourceKubernetesPersistentVolumeClaimRead(d *schema.ResourceData, meta interface{}) error {
+	om := meta_v1.ObjectMeta{
+		Namespace: namespaceOrDefault(d.Get("metadata.0.namespace").(string), meta.(*providerMeta).metadata),
+		Name:      d.Get("metadata.0.name").(string),
+	}
+	d.SetId(buildId(om))
+
+	return resourceKubernetesPersistentVolumeClaimRead(d, dataSourceMeta(meta))
+}
@@ -0,0 +1,45 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDataSourceKubernetesPersistentVolumeClaimRead(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/api/v1/namespaces/default/persistentvolumeclaims/tf-test": &api.PersistentVolumeClaim{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "tf-test", Namespace: "default"},
+			Spec: api.PersistentVolumeClaimSpec{
+				AccessModes: []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+				Resources: api.ResourceRequirements{
+					Requests: api.ResourceList{api.ResourceStorage: resource.MustParse("5Gi")},
+				},
+				VolumeName:       "pvc-8f1c7f0e",
+				StorageClassName: ptrToString("standard"),
+			},
+		},
+	})
+	defer closeFn()
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesPersistentVolumeClaim().Schema, map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{"name": "tf-test"},
+		},
+	})
+	if err := dataSourceKubernetesPersistentVolumeClaimRead(d, testProviderMeta(conn)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	checkResourceDataValues(t, d, map[string]interface{}{
+		"id":                                  "default/tf-test",
+		"spec.0.access_modes.#":               1,
+		"spec.0.resources.0.requests.storage": "5Gi",
+		"spec.0.volume_name":                  "pvc-8f1c7f0e",
+		"spec.0.storage_class_name":           "standard",
+	})
+}
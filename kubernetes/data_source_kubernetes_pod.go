@@ -0,0 +1,27 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesPod() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesPodRead,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("pod", false),
+			"spec":     computedSchema(resourceKubernetesPod().Schema["spec"]),
+		},
+	}
+}
+
+func dataSourceKubernetesPodRead(d *schema.ResourceData, meta interface{}) error {
+	om := meta_v1.ObjectMeta{
+		Namespace: namespaceOrDefault(d.Get("metadata.0.namespace").(string), meta.(*providerMeta).metadata),
+		Name:      d.Get("metadata.0.name").(string),
+	}
+	d.SetId(buildId(om))
+
+	return resourceKubernetesPodRead(d, dataSourceMeta(meta))
+}
@@ -0,0 +1,50 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDataSourceKubernetesPodRead(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/api/v1/namespaces/default/pods/tf-test": &api.Pod{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "tf-test", Namespace: "default"},
+			Spec: api.PodSpec{
+				Containers: []api.Container{
+					{
+						Name:  "web",
+						Image: "nginx:1.15",
+						Ports: []api.ContainerPort{{ContainerPort: 80, Protocol: api.ProtocolTCP}},
+					},
+				},
+				NodeName:           "node-1",
+				ServiceAccountName: "default",
+				RestartPolicy:      api.RestartPolicyAlways,
+			},
+		},
+	})
+	defer closeFn()
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesPod().Schema, map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{"name": "tf-test"},
+		},
+	})
+	if err := dataSourceKubernetesPodRead(d, testProviderMeta(conn)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	checkResourceDataValues(t, d, map[string]interface{}{
+		"id":                       "default/tf-test",
+		"spec.0.container.0.name":  "web",
+		"spec.0.container.0.image": "nginx:1.15",
+		"spec.0.container.0.port.0.container_port": 80,
+		"spec.0.node_name":                         "node-1",
+		"spec.0.service_account_name":              "default",
+		"spec.0.restart_policy":                    "Always",
+	})
+}
@@ -0,0 +1,91 @@
+package kubernetes
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+)
+
+func dataSourceKubernetesPods() *schema.Resource {
+	fields := listSelectorFields("pods")
+	fields["namespace"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Namespace to list the pods from. Defaults to all namespaces.",
+		Optional:    true,
+	}
+	fields["pods"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The pods matching the selectors.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metadata": computedSchema(namespacedMetadataSchema("pod", false)),
+				"host_ip": {
+					Type:        schema.TypeString,
+					Description: "IP address of the node the pod is running on.",
+					Computed:    true,
+				},
+				"node_name": {
+					Type:        schema.TypeString,
+					Description: "Name of the node the pod is scheduled on.",
+					Computed:    true,
+				},
+				"phase": {
+					Type:        schema.TypeString,
+					Description: "The phase of the pod in its lifecycle: `Pending`, `Running`, `Succeeded`, `Failed` or `Unknown`.",
+					Computed:    true,
+				},
+				"pod_ip": {
+					Type:        schema.TypeString,
+					Description: "IP address allocated to the pod.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceKubernetesPodsRead,
+		Schema: fields,
+	}
+}
+
+func dataSourceKubernetesPodsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+	config := dataSourceMeta(meta).metadata
+
+	namespace := d.Get("namespace").(string)
+	opts := expandListOptions(d)
+
+	pods := make([]interface{}, 0)
+	for {
+		log.Printf("[INFO] Listing pods in namespace %q: %s", namespace, redacted(opts))
+		out, err := conn.CoreV1().Pods(namespace).List(opts)
+		if err != nil {
+			log.Printf("[DEBUG] Received error: %s", redacted(err))
+			return err
+		}
+		for _, p := range out.Items {
+			pods = append(pods, flattenPodSummary(p, config))
+		}
+		if out.Continue == "" {
+			break
+		}
+		opts.Continue = out.Continue
+	}
+	log.Printf("[INFO] Received %d pods", len(pods))
+
+	d.SetId(buildListId(namespace, opts))
+	return d.Set("pods", pods)
+}
+
+func flattenPodSummary(in api.Pod, config *metadataConfig) map[string]interface{} {
+	return map[string]interface{}{
+		"metadata":  flattenMetadata(in.ObjectMeta, nil, config),
+		"host_ip":   in.Status.HostIP,
+		"node_name": in.Spec.NodeName,
+		"phase":     string(in.Status.Phase),
+		"pod_ip":    in.Status.PodIP,
+	}
+}
@@ -0,0 +1,56 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDataSourceKubernetesPodsRead(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/api/v1/namespaces/default/pods?labelSelector=app%3Dweb&limit=500": &api.PodList{
+			ListMeta: meta_v1.ListMeta{Continue: "page-2"},
+			Items: []api.Pod{
+				{
+					ObjectMeta: meta_v1.ObjectMeta{Name: "web-1", Namespace: "default"},
+					Spec:       api.PodSpec{NodeName: "node-1"},
+					Status:     api.PodStatus{Phase: api.PodRunning, HostIP: "10.0.0.1", PodIP: "172.16.0.1"},
+				},
+			},
+		},
+		"/api/v1/namespaces/default/pods?continue=page-2&labelSelector=app%3Dweb&limit=500": &api.PodList{
+			Items: []api.Pod{
+				{
+					ObjectMeta: meta_v1.ObjectMeta{Name: "web-2", Namespace: "default"},
+					Status:     api.PodStatus{Phase: api.PodPending},
+				},
+			},
+		},
+	})
+	defer closeFn()
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesPods().Schema, map[string]interface{}{
+		"namespace":      "default",
+		"label_selector": "app=web",
+	})
+	if err := dataSourceKubernetesPodsRead(d, testProviderMeta(conn)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	checkResourceDataValues(t, d, map[string]interface{}{
+		"id":                          "default/app=web/",
+		"pods.#":                      2,
+		"pods.0.metadata.0.name":      "web-1",
+		"pods.0.metadata.0.namespace": "default",
+		"pods.0.node_name":            "node-1",
+		"pods.0.phase":                "Running",
+		"pods.0.host_ip":              "10.0.0.1",
+		"pods.0.pod_ip":               "172.16.0.1",
+		"pods.1.metadata.0.name":      "web-2",
+		"pods.1.phase":                "Pending",
+		"pods.1.node_name":            "",
+	})
+}
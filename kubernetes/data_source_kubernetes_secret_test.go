@@ -0,0 +1,56 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDataSourceKubernetesSecretRead(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/api/v1/namespaces/default/secrets/tf-test": &api.Secret{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "tf-test",
+				Namespace: "default",
+				Labels:    map[string]string{"app": "tf-test"},
+			},
+			Data: map[string][]byte{"password": []byte("s3cr3t")},
+			Type: api.SecretTypeOpaque,
+		},
+	})
+	defer closeFn()
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesSecret().Schema, map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{"name": "tf-test"},
+		},
+	})
+	if err := dataSourceKubernetesSecretRead(d, testProviderMeta(conn)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"id":                    "default/tf-test",
+		"metadata.0.labels.app": "tf-test",
+		"data.password":         "s3cr3t",
+		"type":                  "Opaque",
+	}
+	checkResourceDataValues(t, d, expected)
+}
+
+func TestDataSourceKubernetesSecretRead_notFound(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{})
+	defer closeFn()
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesSecret().Schema, map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{"name": "tf-test"},
+		},
+	})
+	if err := dataSourceKubernetesSecretRead(d, testProviderMeta(conn)); err == nil {
+		t.Fatal("Expected an error when the secret doesn't exist")
+	}
+}
@@ -35,6 +35,16 @@ func dataSourceKubernetesService() *schema.Resource {
 							Description: "The external reference that kubedns or equivalent will return as a CNAME record for this service. No proxying will be involved. Must be a valid DNS name and requires `type` to be `ExternalName`.",
 							Computed:    true,
 						},
+						"external_traffic_policy": {
+							Type:        schema.TypeString,
+							Description: "Denotes if this service routes external traffic to node-local (`Local`) or cluster-wide (`Cluster`) endpoints.",
+							Computed:    true,
+						},
+						"health_check_node_port": {
+							Type:        schema.TypeInt,
+							Description: "The node port serving the health check of the service. Only applies to `type = LoadBalancer` with `external_traffic_policy = Local`.",
+							Computed:    true,
+						},
 						"load_balancer_ip": {
 							Type:        schema.TypeString,
 							Description: "Only applies to `type = LoadBalancer`. LoadBalancer will get created with the IP specified in this field. This feature depends on whether the underlying cloud-provider supports specifying this field when a load balancer is created. This field will be ignored if the cloud-provider does not support the feature.",
@@ -82,6 +92,11 @@ func dataSourceKubernetesService() *schema.Resource {
 								},
 							},
 						},
+						"publish_not_ready_addresses": {
+							Type:        schema.TypeBool,
+							Description: "Whether DNS implementations publish the addresses of the pods targeted by the service even if the pods aren't ready.",
+							Computed:    true,
+						},
 						"selector": {
 							Type:        schema.TypeMap,
 							Description: "Route service traffic to pods with label keys and values matching this selector. Only applies to types `ClusterIP`, `NodePort`, and `LoadBalancer`. More info: http://kubernetes.io/docs/user-guide/services#overview",
@@ -92,6 +107,29 @@ func dataSourceKubernetesService() *schema.Resource {
 							Description: "Used to maintain session affinity. Supports `ClientIP` and `None`. Defaults to `None`. More info: http://kubernetes.io/docs/user-guide/services#virtual-ips-and-service-proxies",
 							Computed:    true,
 						},
+						"session_affinity_config": {
+							Type:        schema.TypeList,
+							Description: "The configuration of the session affinity.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_ip": {
+										Type:        schema.TypeList,
+										Description: "The configuration of the session affinity based on the client IP.",
+										Computed:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"timeout_seconds": {
+													Type:        schema.TypeInt,
+													Description: "The number of seconds a session sticks to the same pod.",
+													Computed:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"type": {
 							Type:        schema.TypeString,
 							Description: "Determines how the service is exposed. Defaults to `ClusterIP`. Valid options are `ExternalName`, `ClusterIP`, `NodePort`, and `LoadBalancer`. `ExternalName` maps to the specified `external_name`. More info: http://kubernetes.io/docs/user-guide/services#overview",
@@ -122,10 +160,10 @@ func dataSourceKubernetesService() *schema.Resource {
 
 func dataSourceKubernetesServiceRead(d *schema.ResourceData, meta interface{}) error {
 	om := meta_v1.ObjectMeta{
-		Namespace: d.Get("metadata.0.namespace").(string),
+		Namespace: namespaceOrDefault(d.Get("metadata.0.namespace").(string), meta.(*providerMeta).metadata),
 		Name:      d.Get("metadata.0.name").(string),
 	}
 	d.SetId(buildId(om))
 
-	return resourceKubernetesServiceRead(d, meta)
+	return resourceKubernetesServiceRead(d, dataSourceMeta(meta))
 }
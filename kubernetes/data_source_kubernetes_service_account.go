@@ -0,0 +1,31 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesServiceAccount() *schema.Resource {
+	sa := resourceKubernetesServiceAccount().Schema
+
+	return &schema.Resource{
+		Read: dataSourceKubernetesServiceAccountRead,
+
+		Schema: map[string]*schema.Schema{
+			"metadata":            namespacedMetadataSchema("service account", false),
+			"image_pull_secret":   computedSchema(sa["image_pull_secret"]),
+			"secret":              computedSchema(sa["secret"]),
+			"default_secret_name": computedSchema(sa["default_secret_name"]),
+		},
+	}
+}
+
+func dataSourceKubernetesServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
+	om := meta_v1.ObjectMeta{
+		Namespace: namespaceOrDefault(d.Get("metadata.0.namespace").(string), meta.(*providerMeta).metadata),
+		Name:      d.Get("metadata.0.name").(string),
+	}
+	d.SetId(buildId(om))
+
+	return resourceKubernetesServiceAccountRead(d, dataSourceMeta(meta))
+}
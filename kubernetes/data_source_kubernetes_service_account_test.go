@@ -0,0 +1,40 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDataSourceKubernetesServiceAccountRead(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/api/v1/namespaces/default/serviceaccounts/tf-test": &api.ServiceAccount{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "tf-test", Namespace: "default"},
+			Secrets: []api.ObjectReference{
+				{Name: "tf-test-token-x7b2k"},
+			},
+			ImagePullSecrets: []api.LocalObjectReference{
+				{Name: "registry"},
+			},
+		},
+	})
+	defer closeFn()
+
+	d := schema.TestResourceDataRaw(t, dataSourceKubernetesServiceAccount().Schema, map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{"name": "tf-test"},
+		},
+	})
+	if err := dataSourceKubernetesServiceAccountRead(d, testProviderMeta(conn)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	checkResourceDataValues(t, d, map[string]interface{}{
+		"id":                  "default/tf-test",
+		"secret.#":            1,
+		"image_pull_secret.#": 1,
+	})
+}
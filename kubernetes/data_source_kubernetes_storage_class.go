@@ -26,5 +26,5 @@ func dataSourceKubernetesStorageClass() *schema.Resource {
 func dataSourceKubernetesStorageClassRead(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get("metadata.0.name").(string)
 	d.SetId(name)
-	return resourceKubernetesStorageClassRead(d, meta)
+	return resourceKubernetesStorageClassRead(d, dataSourceMeta(meta))
 }
@@ -0,0 +1,56 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deletePropagationPolicySchema(objectName string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  fmt.Sprintf("Whether and how the dependents of the %s, e.g. its pods, are garbage collected on deletion. `Foreground` deletes the dependents before the %s, `Background` deletes them after it and `Orphan` keeps them. Defaults to the policy of the API.", objectName, objectName),
+		Optional:     true,
+		ValidateFunc: validateAttributeValueIsIn([]string{"Foreground", "Background", "Orphan"}),
+	}
+}
+
+// expandDeleteOptions returns the delete options configured by
+// delete_propagation_policy
+func expandDeleteOptions(d *schema.ResourceData) *meta_v1.DeleteOptions {
+	opts := &meta_v1.DeleteOptions{}
+	if v, ok := d.GetOk("delete_propagation_policy"); ok {
+		policy := meta_v1.DeletionPropagation(v.(string))
+		opts.PropagationPolicy = &policy
+	}
+	return opts
+}
+
+// waitForDeletion polls the object of the given kind and name through get
+// until the API doesn't find it anymore, so an object with the same name
+// can be created right after. With the Foreground propagation policy the
+// object is only removed once all of its dependents are gone.
+func waitForDeletion(kind, name string, timeout time.Duration, get func() error) error {
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		err := get()
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		log.Printf("[DEBUG] %s %s is still being deleted", kind, name)
+		return resource.RetryableError(fmt.Errorf("%s %s still exists", kind, name))
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] %s %s is gone", kind, name)
+	return nil
+}
@@ -0,0 +1,61 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/apps/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestExpandDeleteOptions(t *testing.T) {
+	foreground := meta_v1.DeletePropagationForeground
+	cases := []struct {
+		Config   map[string]interface{}
+		Expected *meta_v1.DeleteOptions
+	}{
+		{
+			map[string]interface{}{},
+			&meta_v1.DeleteOptions{},
+		},
+		{
+			map[string]interface{}{"delete_propagation_policy": "Foreground"},
+			&meta_v1.DeleteOptions{PropagationPolicy: &foreground},
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceKubernetesDeployment().Schema, tc.Config)
+		output := expandDeleteOptions(d)
+		if !reflect.DeepEqual(output, tc.Expected) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", tc.Expected, output)
+		}
+	}
+}
+
+func TestWaitForDeletion(t *testing.T) {
+	conn, closeConn := newFakeClientset(t, map[string]runtime.Object{
+		"/apis/apps/v1/namespaces/default/deployments/terminating": &api.Deployment{
+			TypeMeta:   meta_v1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+			ObjectMeta: meta_v1.ObjectMeta{Name: "terminating", Namespace: "default"},
+		},
+	})
+	defer closeConn()
+
+	get := func(name string) func() error {
+		return func() error {
+			_, err := conn.AppsV1().Deployments("default").Get(name, meta_v1.GetOptions{})
+			return err
+		}
+	}
+
+	if err := waitForDeletion("Deployment", "gone", time.Second, get("gone")); err != nil {
+		t.Fatalf("Expected a deleted deployment to be reported as gone: %s", err)
+	}
+	if err := waitForDeletion("Deployment", "terminating", time.Second, get("terminating")); err == nil {
+		t.Fatal("Expected a timeout while the deployment still exists")
+	}
+}
@@ -0,0 +1,76 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetes "k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+)
+
+// newFakeClientset returns a clientset talking to an in-memory API server
+// which serves the given objects keyed by their URL path, e.g.
+// /api/v1/namespaces/default/secrets/foo. Objects keyed by a path and
+// its encoded query, e.g. /api/v1/nodes?continue=abc&limit=500, are
+// preferred over the ones keyed by the path only. Any other path is
+// answered with 404 Not Found. The package k8s.io/client-go/kubernetes/fake
+// can't be used here as the resources expect a *kubernetes.Clientset.
+func newFakeClientset(t *testing.T, objects map[string]runtime.Object) (*kubernetes.Clientset, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		obj, ok := objects[r.URL.Path+"?"+r.URL.RawQuery]
+		if !ok {
+			obj, ok = objects[r.URL.Path]
+		}
+		if !ok || r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(meta_v1.Status{
+				TypeMeta: meta_v1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+				Status:   meta_v1.StatusFailure,
+				Message:  r.URL.Path + " not found",
+				Reason:   meta_v1.StatusReasonNotFound,
+				Code:     http.StatusNotFound,
+			})
+			return
+		}
+		if err := json.NewEncoder(w).Encode(obj); err != nil {
+			t.Errorf("Failed to encode %s: %s", r.URL.Path, err)
+		}
+	}))
+
+	conn, err := kubernetes.NewForConfig(&restclient.Config{Host: server.URL})
+	if err != nil {
+		server.Close()
+		t.Fatalf("Failed to configure the fake clientset: %s", err)
+	}
+	return conn, server.Close
+}
+
+// testProviderMeta returns the meta of a provider using the given clientset,
+// configured without any other argument
+func testProviderMeta(conn *kubernetes.Clientset) *providerMeta {
+	return &providerMeta{conn: conn, metadata: &metadataConfig{}}
+}
+
+// checkResourceDataValues compares the given attributes of d with the
+// expected values, the "id" key is compared with d.Id()
+func checkResourceDataValues(t *testing.T, d *schema.ResourceData, expected map[string]interface{}) {
+	for k, v := range expected {
+		var given interface{}
+		if k == "id" {
+			given = d.Id()
+		} else {
+			given = d.Get(k)
+		}
+		if !reflect.DeepEqual(given, v) {
+			t.Errorf("Unexpected value of %s.\nExpected: %#v\nGiven:    %#v", k, v, given)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// listPageSize is the number of objects requested per page when listing,
+// the remaining pages are fetched with the returned continue token
+const listPageSize = 500
+
+func listSelectorFields(objectName string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"field_selector": {
+			Type:         schema.TypeString,
+			Description:  fmt.Sprintf("A selector to restrict the list of returned %s by their fields, e.g. `metadata.name=foo`. Defaults to everything.", objectName),
+			Optional:     true,
+			ValidateFunc: validateFieldSelector,
+		},
+		"label_selector": {
+			Type:         schema.TypeString,
+			Description:  fmt.Sprintf("A selector to restrict the list of returned %s by their labels, e.g. `team=x,tier in (web, api)`. Defaults to everything. More info: http://kubernetes.io/docs/user-guide/labels#label-selectors", objectName),
+			Optional:     true,
+			ValidateFunc: validateLabelSelector,
+		},
+	}
+}
+
+func expandListOptions(d *schema.ResourceData) meta_v1.ListOptions {
+	return meta_v1.ListOptions{
+		LabelSelector: d.Get("label_selector").(string),
+		FieldSelector: d.Get("field_selector").(string),
+		Limit:         listPageSize,
+	}
+}
+
+func buildListId(namespace string, opts meta_v1.ListOptions) string {
+	return fmt.Sprintf("%s/%s/%s", namespace, opts.LabelSelector, opts.FieldSelector)
+}
@@ -0,0 +1,168 @@
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// redactedValue replaces the sensitive values in the logs
+const redactedValue = "<redacted>"
+
+// sensitiveKeys are the keys whose values are masked wherever they appear,
+// e.g. in credentials or in the users of a kube config
+var sensitiveKeys = map[string]bool{
+	"bearerToken":     true,
+	"BearerToken":     true,
+	"client-key-data": true,
+	"keyData":         true,
+	"KeyData":         true,
+	"password":        true,
+	"Password":        true,
+	"token":           true,
+}
+
+// envPatchPath matches the paths of the patch operations which set the
+// environment variables of a container, or one of them, or its value
+var envPatchPath = regexp.MustCompile(`/env(/[0-9]+(/value)?)?$`)
+
+// redacted returns a JSON representation of the given object for the logs,
+// where the data of secrets, the literal values of environment variables,
+// tokens, passwords and client keys are masked. Secrets are recognized by
+// their type or, for raw manifests, by their kind.
+func redacted(obj interface{}) string {
+	switch obj.(type) {
+	case v1.Secret, *v1.Secret, v1.SecretList, *v1.SecretList:
+		return redact(obj, true)
+	}
+	return redact(obj, false)
+}
+
+// redactedSecret is like redacted, but always treats the object as a
+// secret, e.g. to mask the values of the patch operations of a secret
+func redactedSecret(obj interface{}) string {
+	return redact(obj, true)
+}
+
+// redactedJSON is like redacted, but for an object or a patch already
+// encoded as JSON
+func redactedJSON(data []byte) string {
+	return redact(json.RawMessage(data), false)
+}
+
+func redact(obj interface{}, secret bool) string {
+	if err, ok := obj.(error); ok {
+		statusErr, ok := err.(*errors.StatusError)
+		if !ok {
+			return err.Error()
+		}
+		obj = statusErr.ErrStatus
+	}
+
+	var tree interface{}
+	if raw, ok := obj.(json.RawMessage); ok {
+		if err := json.Unmarshal(raw, &tree); err != nil {
+			return fmt.Sprintf("<unable to log %d bytes of raw JSON: %s>", len(raw), err)
+		}
+	} else {
+		// Round trip through JSON to get a copy which can be masked
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return fmt.Sprintf("<unable to log %T: %s>", obj, err)
+		}
+		if err := json.Unmarshal(data, &tree); err != nil {
+			return fmt.Sprintf("<unable to log %T: %s>", obj, err)
+		}
+	}
+
+	redactSecretTree(tree, secret)
+	redactTree(tree)
+
+	// Keep the placeholders readable, they would be escaped otherwise
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(tree); err != nil {
+		return fmt.Sprintf("<unable to log %T: %s>", obj, err)
+	}
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+// redactSecretTree masks the data of a secret or of the secrets of a list,
+// as well as the values of the patch operations of environment variables
+// and, for a secret, of its data
+func redactSecretTree(tree interface{}, secret bool) {
+	switch t := tree.(type) {
+	case map[string]interface{}:
+		kind, _ := t["kind"].(string)
+		if secret || kind == "Secret" {
+			redactMapValues(t["data"])
+			redactMapValues(t["stringData"])
+		}
+		if items, ok := t["items"].([]interface{}); ok {
+			for _, item := range items {
+				redactSecretTree(item, secret || kind == "SecretList")
+			}
+		}
+	case []interface{}:
+		for _, v := range t {
+			op, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if _, ok := op["value"]; !ok {
+				continue
+			}
+			path, _ := op["path"].(string)
+			if envPatchPath.MatchString(path) ||
+				(secret && (strings.HasPrefix(path, "/data") || strings.HasPrefix(path, "/stringData"))) {
+				op["value"] = redactedValue
+			}
+		}
+	}
+}
+
+// redactTree masks the sensitive keys and the literal values of the
+// environment variables anywhere in the given tree
+func redactTree(tree interface{}) {
+	switch t := tree.(type) {
+	case map[string]interface{}:
+		for k, v := range t {
+			if sensitiveKeys[k] {
+				t[k] = redactedValue
+				continue
+			}
+			if k == "env" {
+				if vars, ok := v.([]interface{}); ok {
+					for _, e := range vars {
+						if m, ok := e.(map[string]interface{}); ok {
+							if _, ok := m["value"]; ok {
+								m["value"] = redactedValue
+							}
+						}
+					}
+				}
+			}
+			redactTree(v)
+		}
+	case []interface{}:
+		for _, v := range t {
+			redactTree(v)
+		}
+	}
+}
+
+func redactMapValues(v interface{}) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for k := range m {
+		m[k] = redactedValue
+	}
+}
@@ -0,0 +1,139 @@
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api/v1"
+)
+
+const logTestSecret = "s3cr3t-v4lu3"
+
+func TestRedacted(t *testing.T) {
+	ops := PatchOperations{
+		&ReplaceOperation{Path: "/data/password", Value: base64.StdEncoding.EncodeToString([]byte(logTestSecret))},
+		&AddOperation{Path: "/metadata/labels/app", Value: "web"},
+	}
+	envOps := PatchOperations{
+		&ReplaceOperation{Path: "/spec/containers/0/env/0/value", Value: logTestSecret},
+		&AddOperation{Path: "/spec/containers/0/env/1", Value: map[string]interface{}{"name": "PASSWORD", "value": logTestSecret}},
+	}
+
+	cases := []struct {
+		Name     string
+		Logged   string
+		Expected []string
+	}{
+		{
+			"secret",
+			redacted(&v1.Secret{
+				ObjectMeta: meta_v1.ObjectMeta{Name: "db"},
+				Data:       map[string][]byte{"password": []byte(logTestSecret)},
+				StringData: map[string]string{"username": logTestSecret},
+			}),
+			[]string{`"name":"db"`, `"password":"<redacted>"`, `"username":"<redacted>"`},
+		},
+		{
+			"secret list",
+			redacted(&v1.SecretList{Items: []v1.Secret{
+				{Data: map[string][]byte{"token": []byte(logTestSecret)}},
+			}}),
+			[]string{`"token":"<redacted>"`},
+		},
+		{
+			"pod with literal env",
+			redacted(&v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name: "app",
+				Env: []v1.EnvVar{
+					{Name: "PASSWORD", Value: logTestSecret},
+					{Name: "FROM_SECRET", ValueFrom: &v1.EnvVarSource{
+						SecretKeyRef: &v1.SecretKeySelector{Key: "password"},
+					}},
+				},
+			}}}}),
+			[]string{`"name":"PASSWORD"`, `"value":"<redacted>"`, `"key":"password"`},
+		},
+		{
+			"secret patch operations",
+			redactedSecret(ops),
+			[]string{`"path":"/data/password"`, `"value":"<redacted>"`, `"value":"web"`},
+		},
+		{
+			"env patch operations",
+			redacted(envOps),
+			[]string{`"path":"/spec/containers/0/env/0/value"`, `"value":"<redacted>"`},
+		},
+		{
+			"raw secret manifest",
+			redactedJSON([]byte(`{"apiVersion":"v1","kind":"Secret","data":{"key":"` + logTestSecret + `"}}`)),
+			[]string{`"kind":"Secret"`, `"key":"<redacted>"`},
+		},
+		{
+			"credentials",
+			redacted(&clientcmdapi.AuthInfo{
+				Token:         logTestSecret,
+				Username:      "admin",
+				Password:      logTestSecret,
+				ClientKeyData: []byte(logTestSecret),
+			}),
+			[]string{`"username":"admin"`, `"token":"<redacted>"`, `"password":"<redacted>"`, `"client-key-data":"<redacted>"`},
+		},
+		{
+			"TLS client config",
+			redacted(rest.TLSClientConfig{CertData: []byte("cert"), KeyData: []byte(logTestSecret)}),
+			[]string{`"CertData":"Y2VydA=="`, `"KeyData":"<redacted>"`},
+		},
+		{
+			"kube config",
+			redactedJSON([]byte(`{"users":[{"name":"admin","user":{"token":"` + logTestSecret + `","client-key-data":"` + logTestSecret + `"}}]}`)),
+			[]string{`"name":"admin"`, `"token":"<redacted>"`, `"client-key-data":"<redacted>"`},
+		},
+		{
+			"error",
+			redacted(errors.New("connection refused")),
+			[]string{"connection refused"},
+		},
+	}
+
+	for _, tc := range cases {
+		assertNoSecretLogged(t, tc.Name, tc.Logged)
+		for _, e := range tc.Expected {
+			if !strings.Contains(tc.Logged, e) {
+				t.Fatalf("Expected %s to be logged with %s.\nGiven: %s", tc.Name, e, tc.Logged)
+			}
+		}
+	}
+}
+
+func TestRedacted_logOutput(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	secret := v1.Secret{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "db"},
+		Data:       map[string][]byte{"password": []byte(logTestSecret)},
+	}
+	log.Printf("[INFO] Creating new secret: %s", redacted(secret))
+	log.Printf("[INFO] Submitted new secret: %s", redacted(&secret))
+
+	if !strings.Contains(buf.String(), "Creating new secret") {
+		t.Fatalf("Expected the secret to be logged.\nGiven: %s", buf.String())
+	}
+	assertNoSecretLogged(t, "log output", buf.String())
+}
+
+func assertNoSecretLogged(t *testing.T, name, logged string) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(logTestSecret))
+	if strings.Contains(logged, logTestSecret) || strings.Contains(logged, encoded) {
+		t.Fatalf("Expected the secret value to be masked in %s.\nGiven: %s", name, logged)
+	}
+}
@@ -2,6 +2,8 @@ package kubernetes
 
 import (
 	"encoding/json"
+	"fmt"
+	"log"
 	"reflect"
 	"sort"
 	"strings"
@@ -21,6 +23,15 @@ func diffStringMap(pathPrefix string, oldV, newV map[string]interface{}) PatchOp
 		return ops
 	}
 
+	return diffStringMapKeys(pathPrefix, oldV, newV)
+}
+
+// diffStringMapKeys diffs the maps key by key, leaving the whole map alone
+func diffStringMapKeys(pathPrefix string, oldV, newV map[string]interface{}) PatchOperations {
+	ops := make([]PatchOperation, 0, 0)
+
+	pathPrefix = strings.TrimRight(pathPrefix, "/")
+
 	// This is suboptimal for adding whole new map from scratch
 	// or deleting the whole map, but it's actually intention.
 	// There may be some other map items managed outside of TF
@@ -70,10 +81,77 @@ func escapeJsonPointer(path string) string {
 type PatchOperations []PatchOperation
 
 func (po PatchOperations) MarshalJSON() ([]byte, error) {
-	var v []PatchOperation = po
+	v := make([]PatchOperation, 0, len(po))
+	for _, op := range po {
+		if o, ok := op.(*AddMapOperation); ok {
+			v = append(v, &TestOperation{Path: o.Path}, &AddOperation{Path: o.Path, Value: o.Value})
+			continue
+		}
+		v = append(v, op)
+	}
 	return json.Marshal(v)
 }
 
+// withMapKeys returns the variants of the operations where some of the maps
+// added whole have their keys added one by one instead, starting with all of
+// them
+func (po PatchOperations) withMapKeys() []PatchOperations {
+	var maps []int
+	for i, op := range po {
+		if _, ok := op.(*AddMapOperation); ok {
+			maps = append(maps, i)
+		}
+	}
+
+	variants := make([]PatchOperations, 0)
+	for mask := 1<<uint(len(maps)) - 1; mask > 0; mask-- {
+		keys := make(map[int]bool)
+		for j, i := range maps {
+			keys[i] = mask&(1<<uint(j)) != 0
+		}
+		variant := make(PatchOperations, 0, len(po))
+		for i, op := range po {
+			if keys[i] {
+				o := op.(*AddMapOperation)
+				variant = append(variant, diffStringMapKeys(o.Path, map[string]interface{}{}, o.Value)...)
+				continue
+			}
+			variant = append(variant, op)
+		}
+		variants = append(variants, variant)
+	}
+	return variants
+}
+
+// sendJSONPatch marshals the operations and sends them with the given
+// function. A map added whole can't be told apart from a map the object
+// already has, e.g. with ignored keys, so when the patch fails it's sent
+// again with the keys of such maps added one by one.
+func sendJSONPatch(ops PatchOperations, patch func(data []byte) error) error {
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	err = patch(data)
+	if err == nil {
+		return nil
+	}
+	variants := ops.withMapKeys()
+	if len(variants) > 0 {
+		log.Printf("[DEBUG] Failed to add maps whole (%s), adding their keys one by one", err)
+	}
+	for _, variant := range variants {
+		data, e := variant.MarshalJSON()
+		if e != nil {
+			return fmt.Errorf("Failed to marshal update operations: %s", e)
+		}
+		if e := patch(data); e == nil {
+			return nil
+		}
+	}
+	return err
+}
+
 func (po PatchOperations) Equal(ops []PatchOperation) bool {
 	var v []PatchOperation = po
 
@@ -134,6 +212,49 @@ func (o *AddOperation) String() string {
 	return string(b)
 }
 
+// TestOperation checks that the value at Path equals Value, a nil Value
+// checks that there is nothing at Path
+type TestOperation struct {
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+	Op    string      `json:"op"`
+}
+
+func (o *TestOperation) GetPath() string {
+	return o.Path
+}
+
+func (o *TestOperation) MarshalJSON() ([]byte, error) {
+	o.Op = "test"
+	return json.Marshal(*o)
+}
+
+func (o *TestOperation) String() string {
+	b, _ := o.MarshalJSON()
+	return string(b)
+}
+
+// AddMapOperation adds a whole map of strings at Path, it's sent as a test
+// operation making sure that there is no map at Path yet, followed by an add
+// operation
+type AddMapOperation struct {
+	Path  string
+	Value map[string]interface{}
+}
+
+func (o *AddMapOperation) GetPath() string {
+	return o.Path
+}
+
+func (o *AddMapOperation) MarshalJSON() ([]byte, error) {
+	return PatchOperations{o}.MarshalJSON()
+}
+
+func (o *AddMapOperation) String() string {
+	b, _ := o.MarshalJSON()
+	return string(b)
+}
+
 type RemoveOperation struct {
 	Path string `json:"path"`
 	Op   string `json:"op"`
@@ -152,3 +273,35 @@ func (o *RemoveOperation) String() string {
 	b, _ := o.MarshalJSON()
 	return string(b)
 }
+
+// strategicMergePatchReplacing marshals obj into a strategic merge patch
+// and marks the objects found at the given paths with a replace directive,
+// so that fields removed from the configuration are removed from them too
+func strategicMergePatchReplacing(obj interface{}, paths ...string) ([]byte, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var patch map[string]interface{}
+	err = json.Unmarshal(data, &patch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range paths {
+		m := patch
+		for _, k := range strings.Split(path, ".") {
+			next, ok := m[k].(map[string]interface{})
+			if !ok {
+				m = nil
+				break
+			}
+			m = next
+		}
+		if m != nil {
+			m["$patch"] = "replace"
+		}
+	}
+
+	return json.Marshal(patch)
+}
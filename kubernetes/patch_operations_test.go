@@ -1,7 +1,9 @@
 package kubernetes
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -165,3 +167,81 @@ func TestEscapeJsonPointer(t *testing.T) {
 		}
 	}
 }
+
+func TestStrategicMergePatchReplacing(t *testing.T) {
+	obj := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "test"},
+		"spec": map[string]interface{}{
+			"replicas": 2,
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{"restartPolicy": "Always"},
+			},
+		},
+	}
+
+	data, err := strategicMergePatchReplacing(obj, "spec.template", "spec.missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"metadata":{"name":"test"},"spec":{"replicas":2,"template":{"$patch":"replace","spec":{"restartPolicy":"Always"}}}}`
+	if string(data) != expected {
+		t.Fatalf("Unexpected patch.\nExpected: %s\nGiven:    %s", expected, string(data))
+	}
+}
+
+func TestSendJSONPatch(t *testing.T) {
+	ops := PatchOperations{
+		&AddMapOperation{Path: "/metadata/annotations", Value: map[string]interface{}{"owner": "platform"}},
+		&AddMapOperation{Path: "/metadata/labels", Value: map[string]interface{}{"app": "web"}},
+	}
+
+	testCases := []struct {
+		Live     map[string]bool
+		Expected string
+	}{
+		{
+			map[string]bool{},
+			`[{"path":"/metadata/annotations","value":null,"op":"test"},{"path":"/metadata/annotations","value":{"owner":"platform"},"op":"add"},` +
+				`{"path":"/metadata/labels","value":null,"op":"test"},{"path":"/metadata/labels","value":{"app":"web"},"op":"add"}]`,
+		},
+		{
+			map[string]bool{"/metadata/annotations": true, "/metadata/labels": true},
+			`[{"path":"/metadata/annotations/owner","value":"platform","op":"add"},{"path":"/metadata/labels/app","value":"web","op":"add"}]`,
+		},
+		{
+			map[string]bool{"/metadata/labels": true},
+			`[{"path":"/metadata/annotations","value":null,"op":"test"},{"path":"/metadata/annotations","value":{"owner":"platform"},"op":"add"},` +
+				`{"path":"/metadata/labels/app","value":"web","op":"add"}]`,
+		},
+	}
+	for _, tc := range testCases {
+		// Applies the patch to an object which has the live maps, like
+		// the API server would
+		var applied string
+		patch := func(data []byte) error {
+			var patchOps []map[string]interface{}
+			if err := json.Unmarshal(data, &patchOps); err != nil {
+				return err
+			}
+			for _, op := range patchOps {
+				path := op["path"].(string)
+				switch {
+				case op["op"] == "test" && tc.Live[path]:
+					return fmt.Errorf("testing value %s failed", path)
+				case op["op"] == "add" && strings.Count(path, "/") > 2 && !tc.Live[path[:strings.LastIndex(path, "/")]]:
+					return fmt.Errorf("add operation does not apply: doc is missing path: %s", path)
+				}
+			}
+			applied = string(data)
+			return nil
+		}
+
+		err := sendJSONPatch(ops, patch)
+		if err != nil {
+			t.Fatalf("Failed to send the patch to an object with %v: %s", tc.Live, err)
+		}
+		if applied != tc.Expected {
+			t.Fatalf("Unexpected patch applied to an object with %v.\nExpected: %s\nGiven:    %s", tc.Live, tc.Expected, applied)
+		}
+	}
+}
@@ -4,7 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
@@ -72,6 +76,19 @@ func Provider() terraform.ResourceProvider {
 					"~/.kube/config"),
 				Description: "Path to the kube config file, defaults to ~/.kube/config",
 			},
+			"config_paths": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of paths to kube config files which are merged like the ones of `KUBECONFIG`. Takes precedence over `config_path`. Can be sourced from `KUBE_CONFIG_PATHS`.",
+			},
+			"config_raw": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_CONFIG_RAW", ""),
+				Description: "Content of a kube config file. Takes precedence over `config_path` and `config_paths`.",
+			},
 			"config_context": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -95,6 +112,94 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("KUBE_TOKEN", ""),
 				Description: "Token to authentifcate an service account",
 			},
+			"token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_TOKEN_FILE", ""),
+				Description: "Path to a file containing the token to authenticate with, read again every minute so rotated tokens are picked up.",
+			},
+			"exec": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Credential plugin run to obtain the token to authenticate with, e.g. aws-iam-authenticator.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Version of the ExecCredential objects exchanged with the plugin.",
+							ValidateFunc: validateAttributeValueIsIn(execCredentialAPIVersions),
+						},
+						"command": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Command to run.",
+						},
+						"args": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Arguments passed to the command.",
+						},
+						"env": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Environment variables set for the command, in addition to the ones of Terraform.",
+						},
+					},
+				},
+			},
+			"default_annotations": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateAnnotations,
+				Description:  "Annotations added to every object, unless the object sets them. They are kept out of the state of the objects.",
+			},
+			"default_labels": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateLabels,
+				Description:  "Labels added to every object, unless the object sets them. They are kept out of the state of the objects.",
+			},
+			"default_namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateName,
+				Description:  "Namespace of the objects which don't set one. Defaults to `default`.",
+			},
+			"ignore_annotations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateRegexp},
+				Description: "List of regular expressions matching the keys of annotations managed outside of Terraform, e.g. by controllers. They are neither read into the state nor patched.",
+			},
+			"ignore_labels": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateRegexp},
+				Description: "List of regular expressions matching the keys of labels managed outside of Terraform, e.g. by controllers. They are neither read into the state nor patched.",
+			},
+			"qps": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validatePositiveFloat,
+				Description:  "Maximum number of requests per second to the API server. Defaults to 5.",
+			},
+			"burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validatePositiveInteger,
+				Description:  "Maximum number of requests to the API server sent at once, above the `qps` rate. Defaults to 10.",
+			},
+			"request_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+				Description:  "Timeout of the requests to the API server, including their retries, e.g. `30s`. Defaults to no timeout.",
+			},
 			"load_config_file": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -104,43 +209,70 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"kubernetes_service":       dataSourceKubernetesService(),
-			"kubernetes_storage_class": dataSourceKubernetesStorageClass(),
+			"kubernetes_config_map":              dataSourceKubernetesConfigMap(),
+			"kubernetes_deployment":              dataSourceKubernetesDeployment(),
+			"kubernetes_ingress":                 dataSourceKubernetesIngress(),
+			"kubernetes_namespace":               dataSourceKubernetesNamespace(),
+			"kubernetes_namespaces":              dataSourceKubernetesNamespaces(),
+			"kubernetes_nodes":                   dataSourceKubernetesNodes(),
+			"kubernetes_persistent_volume_claim": dataSourceKubernetesPersistentVolumeClaim(),
+			"kubernetes_pod":                     dataSourceKubernetesPod(),
+			"kubernetes_pods":                    dataSourceKubernetesPods(),
+			"kubernetes_secret":                  dataSourceKubernetesSecret(),
+			"kubernetes_service":                 dataSourceKubernetesService(),
+			"kubernetes_service_account":         dataSourceKubernetesServiceAccount(),
+			"kubernetes_storage_class":           dataSourceKubernetesStorageClass(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"kubernetes_config_map":                resourceKubernetesConfigMap(),
-			"kubernetes_horizontal_pod_autoscaler": resourceKubernetesHorizontalPodAutoscaler(),
-			"kubernetes_limit_range":               resourceKubernetesLimitRange(),
-			"kubernetes_namespace":                 resourceKubernetesNamespace(),
-			"kubernetes_persistent_volume":         resourceKubernetesPersistentVolume(),
-			"kubernetes_persistent_volume_claim":   resourceKubernetesPersistentVolumeClaim(),
-			"kubernetes_pod":                       resourceKubernetesPod(),
-			"kubernetes_replication_controller":    resourceKubernetesReplicationController(),
-			"kubernetes_resource_quota":            resourceKubernetesResourceQuota(),
-			"kubernetes_secret":                    resourceKubernetesSecret(),
-			"kubernetes_service":                   resourceKubernetesService(),
-			"kubernetes_service_account":           resourceKubernetesServiceAccount(),
-			"kubernetes_storage_class":             resourceKubernetesStorageClass(),
-			"kubernetes_cluster_role_binding":      resourceKubernetesClusterRoleBinding(),
-			"kubernetes_cluster_role":              resourceKubernetesClusterRole(),
-			"kubernetes_role_binding":              resourceKubernetesRoleBinding(),
-			"kubernetes_role":                      resourceKubernetesRole(),
-			"kubernetes_statefulset":               resourceKubernetesStatefulSet(),
-			"kubernetes_deployment":               	resourceKubernetesDeployment(),
-			"kubernetes_cronjob":               	resourceKubernetesCronJob(),
-			"kubernetes_ingress":               	resourceKubernetesIngress(),
-			"kubernetes_daemonset":               	resourceKubernetesDaemonSet(),
+			"kubernetes_certificate_signing_request":      resourceKubernetesCertificateSigningRequest(),
+			"kubernetes_config_map":                       resourceKubernetesConfigMap(),
+			"kubernetes_custom_resource_definition":       resourceKubernetesCustomResourceDefinition(),
+			"kubernetes_horizontal_pod_autoscaler":        resourceKubernetesHorizontalPodAutoscaler(),
+			"kubernetes_job":                              resourceKubernetesJob(),
+			"kubernetes_limit_range":                      resourceKubernetesLimitRange(),
+			"kubernetes_manifest":                         resourceKubernetesManifest(),
+			"kubernetes_mutating_webhook_configuration":   resourceKubernetesMutatingWebhookConfiguration(),
+			"kubernetes_namespace":                        resourceKubernetesNamespace(),
+			"kubernetes_network_policy":                   resourceKubernetesNetworkPolicy(),
+			"kubernetes_persistent_volume":                resourceKubernetesPersistentVolume(),
+			"kubernetes_persistent_volume_claim":          resourceKubernetesPersistentVolumeClaim(),
+			"kubernetes_pod":                              resourceKubernetesPod(),
+			"kubernetes_pod_disruption_budget":            resourceKubernetesPodDisruptionBudget(),
+			"kubernetes_pod_security_policy":              resourceKubernetesPodSecurityPolicy(),
+			"kubernetes_priority_class":                   resourceKubernetesPriorityClass(),
+			"kubernetes_replication_controller":           resourceKubernetesReplicationController(),
+			"kubernetes_resource_quota":                   resourceKubernetesResourceQuota(),
+			"kubernetes_secret":                           resourceKubernetesSecret(),
+			"kubernetes_service":                          resourceKubernetesService(),
+			"kubernetes_service_account":                  resourceKubernetesServiceAccount(),
+			"kubernetes_storage_class":                    resourceKubernetesStorageClass(),
+			"kubernetes_validating_webhook_configuration": resourceKubernetesValidatingWebhookConfiguration(),
+			"kubernetes_cluster_role_binding":             resourceKubernetesClusterRoleBinding(),
+			"kubernetes_cluster_role":                     resourceKubernetesClusterRole(),
+			"kubernetes_role_binding":                     resourceKubernetesRoleBinding(),
+			"kubernetes_role":                             resourceKubernetesRole(),
+			"kubernetes_statefulset":                      resourceKubernetesStatefulSet(),
+			"kubernetes_deployment":                       resourceKubernetesDeployment(),
+			"kubernetes_cronjob":                          resourceKubernetesCronJob(),
+			"kubernetes_ingress":                          resourceKubernetesIngress(),
+			"kubernetes_daemonset":                        resourceKubernetesDaemonSet(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
 }
 
+// providerMeta is the meta passed to the resources and data sources
+type providerMeta struct {
+	conn     *kubernetes.Clientset
+	metadata *metadataConfig
+}
+
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	var cfg *restclient.Config
 	var err error
-	if d.Get("load_config_file").(bool) {
+	if _, ok := d.GetOk("config_raw"); ok || d.Get("load_config_file").(bool) {
 		// Config file loading
 		cfg, err = tryLoadingConfigFile(d)
 	}
@@ -180,22 +312,80 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		cfg.BearerToken = v.(string)
 	}
 
+	source, err := credentialTokenSource(d)
+	if err != nil {
+		return nil, err
+	}
+	if source != nil {
+		// The credential plugin or token file takes over from the other
+		// credentials, their headers would be set first otherwise
+		cfg.BearerToken = ""
+		cfg.Username = ""
+		cfg.Password = ""
+		cfg.AuthProvider = nil
+		wrap := cfg.WrapTransport
+		cfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+			if wrap != nil {
+				rt = wrap(rt)
+			}
+			return newTokenRoundTripper(source, rt)
+		}
+	}
+
+	if v, ok := d.GetOk("qps"); ok {
+		cfg.QPS = float32(v.(float64))
+	}
+	if v, ok := d.GetOk("burst"); ok {
+		cfg.Burst = v.(int)
+	}
+	if v, ok := d.GetOk("request_timeout"); ok {
+		timeout, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse request_timeout: %s", err)
+		}
+		cfg.Timeout = timeout
+	}
+	wrap := cfg.WrapTransport
+	cfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if wrap != nil {
+			rt = wrap(rt)
+		}
+		return newRetryRoundTripper(rt)
+	}
+
 	k, err := kubernetes.NewForConfig(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to configure: %s", err)
 	}
 
-	return k, nil
-}
-
-func tryLoadingConfigFile(d *schema.ResourceData) (*restclient.Config, error) {
-	path, err := homedir.Expand(d.Get("config_path").(string))
+	metadata, err := expandMetadataConfig(d)
 	if err != nil {
 		return nil, err
 	}
 
-	loader := &clientcmd.ClientConfigLoadingRules{
-		ExplicitPath: path,
+	return &providerMeta{conn: k, metadata: metadata}, nil
+}
+
+func tryLoadingConfigFile(d *schema.ResourceData) (*restclient.Config, error) {
+	loader := &clientcmd.ClientConfigLoadingRules{}
+
+	var path string
+	if paths := configPaths(d); len(paths) > 0 {
+		for _, p := range paths {
+			p, err := homedir.Expand(p)
+			if err != nil {
+				return nil, err
+			}
+			loader.Precedence = append(loader.Precedence, p)
+		}
+		path = strings.Join(loader.Precedence, string(filepath.ListSeparator))
+	} else {
+		p, err := homedir.Expand(d.Get("config_path").(string))
+		if err != nil {
+			return nil, err
+		}
+		loader.ExplicitPath = p
+		path = p
 	}
 
 	overrides := &clientcmd.ConfigOverrides{}
@@ -221,19 +411,48 @@ func tryLoadingConfigFile(d *schema.ResourceData) (*restclient.Config, error) {
 			overrides.Context.Cluster = cluster.(string)
 			ctxSuffix += fmt.Sprintf("; cluster: %s", overrides.Context.Cluster)
 		}
-		log.Printf("[DEBUG] Using overidden context: %#v", overrides.Context)
+		log.Printf("[DEBUG] Using overidden context: %s", redacted(overrides.Context))
+	}
+
+	var cc clientcmd.ClientConfig
+	if raw, ok := d.GetOk("config_raw"); ok {
+		rawCfg, err := clientcmd.Load([]byte(raw.(string)))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse config_raw: %s", err)
+		}
+		cc = clientcmd.NewNonInteractiveClientConfig(*rawCfg, rawCfg.CurrentContext, overrides, nil)
+		path = "config_raw"
+	} else {
+		cc = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, overrides)
 	}
 
-	cc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, overrides)
 	cfg, err := cc.ClientConfig()
 	if err != nil {
 		if pathErr, ok := err.(*os.PathError); ok && os.IsNotExist(pathErr.Err) {
 			log.Printf("[INFO] Unable to load config file as it doesn't exist at %q", path)
 			return nil, nil
 		}
+		if len(loader.Precedence) > 0 && clientcmd.IsEmptyConfig(err) {
+			// Missing files are skipped when merging several of them
+			log.Printf("[INFO] Unable to load config files as none of them exists at %q", path)
+			return nil, nil
+		}
 		return nil, fmt.Errorf("Failed to load config (%s%s): %s", path, ctxSuffix, err)
 	}
 
 	log.Printf("[INFO] Successfully loaded config file (%s%s)", path, ctxSuffix)
 	return cfg, nil
 }
+
+// configPaths returns the kube config files to merge, either from
+// config_paths or from KUBE_CONFIG_PATHS, which is a list separated
+// like PATH
+func configPaths(d *schema.ResourceData) []string {
+	if v, ok := d.GetOk("config_paths"); ok {
+		return expandStringSlice(v.([]interface{}))
+	}
+	if v := os.Getenv("KUBE_CONFIG_PATHS"); v != "" {
+		return filepath.SplitList(v)
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mitchellh/go-homedir"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+// tokenFileRereadPeriod is how long a token read from token_file is used
+// before the file is read again, so rotated tokens are picked up
+const tokenFileRereadPeriod = time.Minute
+
+// execCredentialAPIVersions are the versions of the ExecCredential objects
+// which can be exchanged with an exec credential plugin
+var execCredentialAPIVersions = []string{
+	"client.authentication.k8s.io/v1alpha1",
+	"client.authentication.k8s.io/v1beta1",
+}
+
+// tokenSource returns the bearer token to authenticate the requests with
+type tokenSource interface {
+	Token() (string, error)
+}
+
+// tokenRoundTripper sets the token of the given source as bearer token of
+// the requests which aren't authenticated yet
+type tokenRoundTripper struct {
+	source tokenSource
+	rt     http.RoundTripper
+}
+
+func newTokenRoundTripper(source tokenSource, rt http.RoundTripper) http.RoundTripper {
+	return &tokenRoundTripper{source: source, rt: rt}
+}
+
+func (t *tokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(req.Header.Get("Authorization")) != 0 {
+		return t.rt.RoundTrip(req)
+	}
+
+	token, err := t.source.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	req = utilnet.CloneRequest(req)
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.rt.RoundTrip(req)
+}
+
+// cachedToken holds a token along with the time it expires at, the zero
+// time meaning it never expires
+type cachedToken struct {
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+	now    func() time.Time
+}
+
+// get returns the cached token if it's still valid, calling refresh to
+// obtain a new token and its expiry otherwise
+func (c *cachedToken) get(refresh func(now time.Time) (string, time.Time, error)) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if c.now != nil {
+		now = c.now()
+	}
+	if c.token != "" && (c.expiry.IsZero() || now.Before(c.expiry)) {
+		return c.token, nil
+	}
+
+	token, expiry, err := refresh(now)
+	if err != nil {
+		return "", err
+	}
+	c.token, c.expiry = token, expiry
+	return token, nil
+}
+
+// fileTokenSource reads the token from a file, e.g. the token of a service
+// account projected into a pod, and reads it again once the reread period
+// is over
+type fileTokenSource struct {
+	path   string
+	period time.Duration
+	cache  cachedToken
+}
+
+func newFileTokenSource(path string) *fileTokenSource {
+	return &fileTokenSource{path: path, period: tokenFileRereadPeriod}
+}
+
+func (s *fileTokenSource) Token() (string, error) {
+	return s.cache.get(func(now time.Time) (string, time.Time, error) {
+		log.Printf("[DEBUG] Reading token from %q", s.path)
+		b, err := ioutil.ReadFile(s.path)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("Failed to read token file: %s", err)
+		}
+		token := strings.TrimSpace(string(b))
+		if token == "" {
+			return "", time.Time{}, fmt.Errorf("Token file %q is empty", s.path)
+		}
+		return token, now.Add(s.period), nil
+	})
+}
+
+// execCredential is the object exchanged with exec credential plugins,
+// see https://kubernetes.io/docs/reference/access-authn-authz/authentication/#client-go-credential-plugins
+type execCredential struct {
+	APIVersion string                `json:"apiVersion"`
+	Kind       string                `json:"kind"`
+	Spec       struct{}              `json:"spec"`
+	Status     *execCredentialStatus `json:"status,omitempty"`
+}
+
+type execCredentialStatus struct {
+	ExpirationTimestamp *time.Time `json:"expirationTimestamp,omitempty"`
+	Token               string     `json:"token"`
+}
+
+// execTokenSource runs a credential plugin, e.g. aws-iam-authenticator,
+// to obtain a token and runs it again once the token has expired
+type execTokenSource struct {
+	apiVersion string
+	command    string
+	args       []string
+	env        []string
+	cache      cachedToken
+}
+
+func (s *execTokenSource) Token() (string, error) {
+	return s.cache.get(func(now time.Time) (string, time.Time, error) {
+		info, err := json.Marshal(execCredential{APIVersion: s.apiVersion, Kind: "ExecCredential"})
+		if err != nil {
+			return "", time.Time{}, err
+		}
+
+		log.Printf("[DEBUG] Running credential plugin %q", s.command)
+		var stdout, stderr bytes.Buffer
+		cmd := exec.Command(s.command, s.args...)
+		cmd.Env = append(append(os.Environ(), s.env...), "KUBERNETES_EXEC_INFO="+string(info))
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return "", time.Time{}, fmt.Errorf("Failed to run credential plugin %q: %s: %s", s.command, err, strings.TrimSpace(stderr.String()))
+		}
+
+		cred := execCredential{}
+		if err := json.Unmarshal(stdout.Bytes(), &cred); err != nil {
+			return "", time.Time{}, fmt.Errorf("Failed to decode the output of credential plugin %q: %s", s.command, err)
+		}
+		if cred.APIVersion != s.apiVersion {
+			return "", time.Time{}, fmt.Errorf("Credential plugin %q returned apiVersion %q, expected %q", s.command, cred.APIVersion, s.apiVersion)
+		}
+		if cred.Status == nil || cred.Status.Token == "" {
+			return "", time.Time{}, fmt.Errorf("Credential plugin %q didn't return a token", s.command)
+		}
+
+		var expiry time.Time
+		if cred.Status.ExpirationTimestamp != nil {
+			expiry = *cred.Status.ExpirationTimestamp
+		}
+		return cred.Status.Token, expiry, nil
+	})
+}
+
+func expandExecTokenSource(l []interface{}) *execTokenSource {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	s := &execTokenSource{
+		apiVersion: in["api_version"].(string),
+		command:    in["command"].(string),
+	}
+	if v, ok := in["args"].([]interface{}); ok {
+		s.args = expandStringSlice(v)
+	}
+	if v, ok := in["env"].(map[string]interface{}); ok {
+		for k, v := range v {
+			s.env = append(s.env, k+"="+v.(string))
+		}
+		// Keep the environment stable, it's built from a map
+		sort.Strings(s.env)
+	}
+	return s
+}
+
+// credentialTokenSource returns the token source configured by exec or
+// token_file, if any
+func credentialTokenSource(d *schema.ResourceData) (tokenSource, error) {
+	if v, ok := d.GetOk("exec"); ok {
+		if s := expandExecTokenSource(v.([]interface{})); s != nil {
+			return s, nil
+		}
+	}
+	if v, ok := d.GetOk("token_file"); ok {
+		path, err := homedir.Expand(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		return newFileTokenSource(path), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,123 @@
+package kubernetes
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileTokenSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-acc-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(path, []byte("first\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	s := newFileTokenSource(path)
+	s.cache.now = func() time.Time { return now }
+
+	checks := []struct {
+		Name     string
+		Content  string
+		Elapsed  time.Duration
+		Expected string
+	}{
+		{"first read", "", 0, "first"},
+		{"within the reread period", "second", tokenFileRereadPeriod / 2, "first"},
+		{"after the reread period", "", tokenFileRereadPeriod, "second"},
+	}
+	for _, c := range checks {
+		if c.Content != "" {
+			if err := ioutil.WriteFile(path, []byte(c.Content), 0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+		now = now.Add(c.Elapsed)
+
+		token, err := s.Token()
+		if err != nil {
+			t.Fatalf("Unexpected error on %s: %s", c.Name, err)
+		}
+		if token != c.Expected {
+			t.Fatalf("Unexpected token on %s.\nExpected: %q\nGiven:    %q", c.Name, c.Expected, token)
+		}
+	}
+}
+
+func TestExecTokenSource(t *testing.T) {
+	expiry := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	s := expandExecTokenSource([]interface{}{
+		map[string]interface{}{
+			"api_version": "client.authentication.k8s.io/v1alpha1",
+			"command":     "sh",
+			"args": []interface{}{
+				"-c",
+				`echo '{"apiVersion": "client.authentication.k8s.io/v1alpha1", "kind": "ExecCredential", "status": {"token": "'"$TF_ACC_TEST_TOKEN"'", "expirationTimestamp": "` + expiry + `"}}'`,
+			},
+			"env": map[string]interface{}{
+				"TF_ACC_TEST_TOKEN": "secret",
+			},
+		},
+	})
+
+	token, err := s.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "secret" {
+		t.Fatalf("Unexpected token.\nExpected: %q\nGiven:    %q", "secret", token)
+	}
+	if s.cache.expiry.UTC().Format(time.RFC3339) != expiry {
+		t.Fatalf("Unexpected expiry.\nExpected: %s\nGiven:    %s", expiry, s.cache.expiry)
+	}
+
+	s.args = nil
+	s.command = "false"
+	s.cache.token = ""
+	if _, err := s.Token(); err == nil {
+		t.Fatal("Expected an error from a failing credential plugin")
+	}
+}
+
+func TestTokenRoundTripper(t *testing.T) {
+	var given []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		given = append(given, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: newTokenRoundTripper(staticTokenSource("secret"), http.DefaultTransport),
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	if _, err := client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	req, _ = http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header.Set("Authorization", "Basic Zm9vOmJhcg==")
+	if _, err := client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"Bearer secret", "Basic Zm9vOmJhcg=="}
+	if len(given) != len(expected) || given[0] != expected[0] || given[1] != expected[1] {
+		t.Fatalf("Unexpected Authorization headers.\nExpected: %#v\nGiven:    %#v", expected, given)
+	}
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token() (string, error) {
+	return string(s), nil
+}
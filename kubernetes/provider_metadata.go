@@ -0,0 +1,173 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// metadataConfig holds the provider settings which apply to the metadata
+// of every object
+type metadataConfig struct {
+	ignoreAnnotations  []*regexp.Regexp
+	ignoreLabels       []*regexp.Regexp
+	defaultAnnotations map[string]string
+	defaultLabels      map[string]string
+	defaultNamespace   string
+}
+
+func expandMetadataConfig(d *schema.ResourceData) (*metadataConfig, error) {
+	c := &metadataConfig{}
+	var err error
+	if c.ignoreAnnotations, err = expandRegexps(d.Get("ignore_annotations").([]interface{})); err != nil {
+		return nil, fmt.Errorf("Failed to parse ignore_annotations: %s", err)
+	}
+	if c.ignoreLabels, err = expandRegexps(d.Get("ignore_labels").([]interface{})); err != nil {
+		return nil, fmt.Errorf("Failed to parse ignore_labels: %s", err)
+	}
+	c.defaultAnnotations = expandStringMap(d.Get("default_annotations").(map[string]interface{}))
+	c.defaultLabels = expandStringMap(d.Get("default_labels").(map[string]interface{}))
+	c.defaultNamespace = d.Get("default_namespace").(string)
+	return c, nil
+}
+
+// defaultNamespace is the namespace of the objects which don't set one,
+// default_namespace if set
+func defaultNamespace(c *metadataConfig) string {
+	if c.defaultNamespace != "" {
+		return c.defaultNamespace
+	}
+	return "default"
+}
+
+// namespaceOrDefault returns the given namespace, the default one if empty
+func namespaceOrDefault(namespace string, c *metadataConfig) string {
+	if namespace == "" {
+		return defaultNamespace(c)
+	}
+	return namespace
+}
+
+// dataSourceMeta returns the meta of the provider for the data sources, which
+// report the annotations and labels set to their defaults too
+func dataSourceMeta(meta interface{}) *providerMeta {
+	m := *meta.(*providerMeta)
+	config := *m.metadata
+	config.defaultAnnotations = nil
+	config.defaultLabels = nil
+	m.metadata = &config
+	return &m
+}
+
+func expandRegexps(l []interface{}) ([]*regexp.Regexp, error) {
+	res := make([]*regexp.Regexp, 0, len(l))
+	for _, v := range l {
+		re, err := regexp.Compile(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
+
+func isIgnoredKey(key string, rules []*regexp.Regexp) bool {
+	for _, re := range rules {
+		if re.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeIgnoredKeys removes the keys matching any of the given rules, like
+// removeInternalKeys does for the keys of Kubernetes
+func removeIgnoredKeys(m map[string]string, rules []*regexp.Regexp) map[string]string {
+	for k := range m {
+		if isIgnoredKey(k, rules) {
+			delete(m, k)
+		}
+	}
+	return m
+}
+
+// withoutIgnoredKeys returns a copy of the given map of the state without
+// the keys matching any of the given rules, so they are never patched
+func withoutIgnoredKeys(m map[string]interface{}, rules []*regexp.Regexp) map[string]interface{} {
+	res := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if !isIgnoredKey(k, rules) {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// withLiveIgnoredKeys returns a copy of the given map with the keys of the
+// live map which are internal to Kubernetes or match any of the given rules,
+// so that replacing the live map as a whole keeps them
+func withLiveIgnoredKeys(m, live map[string]string, rules []*regexp.Regexp) map[string]string {
+	res := make(map[string]string, len(m))
+	for k, v := range live {
+		if isInternalKey(k) || isIgnoredKey(k, rules) {
+			res[k] = v
+		}
+	}
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
+
+// keepIgnoredMetadata copies the ignored annotations and labels of the live
+// metadata into the given one, e.g. for a pod template replaced as a whole
+func keepIgnoredMetadata(meta *metav1.ObjectMeta, live metav1.ObjectMeta, config *metadataConfig) {
+	meta.Annotations = withLiveIgnoredKeys(meta.Annotations, live.Annotations, config.ignoreAnnotations)
+	meta.Labels = withLiveIgnoredKeys(meta.Labels, live.Labels, config.ignoreLabels)
+}
+
+// withDefaults returns a copy of the given map with the given defaults for
+// the keys it doesn't set
+func withDefaults(m map[string]string, defaults map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return m
+	}
+	res := make(map[string]string, len(m)+len(defaults))
+	for k, v := range defaults {
+		res[k] = v
+	}
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
+
+// withStateDefaults is like withDefaults for a map of the state
+func withStateDefaults(m map[string]interface{}, defaults map[string]string) map[string]interface{} {
+	res := make(map[string]interface{}, len(m)+len(defaults))
+	for k, v := range defaults {
+		res[k] = v
+	}
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
+
+// removeDefaultKeys removes the keys still set to their default, so the
+// defaults are kept out of the state. The keys of the given configured map
+// are kept, as is a key set to another value, it was either set in the
+// configuration or the default has changed.
+func removeDefaultKeys(m map[string]string, defaults map[string]string, configured map[string]interface{}) map[string]string {
+	for k, v := range m {
+		if _, ok := configured[k]; ok {
+			continue
+		}
+		if d, ok := defaults[k]; ok && d == v {
+			delete(m, k)
+		}
+	}
+	return m
+}
@@ -0,0 +1,322 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExpandMetadata_defaults(t *testing.T) {
+	config := &metadataConfig{
+		defaultAnnotations: map[string]string{"owner": "platform"},
+		defaultLabels:      map[string]string{"managed-by": "terraform", "team": "platform"},
+		defaultNamespace:   "platform",
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceKubernetesConfigMap().Schema, map[string]interface{}{
+		"metadata": []interface{}{map[string]interface{}{
+			"name":   "web",
+			"labels": map[string]interface{}{"app": "web", "team": "web"},
+		}},
+	})
+
+	meta := expandMetadata(d.Get("metadata").([]interface{}), config)
+	expected := metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "platform",
+		Annotations: map[string]string{"owner": "platform"},
+		Labels:      map[string]string{"app": "web", "managed-by": "terraform", "team": "web"},
+	}
+	if !reflect.DeepEqual(meta, expected) {
+		t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", expected, meta)
+	}
+}
+
+func TestFlattenMetadata_defaultKeys(t *testing.T) {
+	config := &metadataConfig{
+		defaultLabels: map[string]string{"managed-by": "terraform", "team": "platform"},
+	}
+
+	meta := metav1.ObjectMeta{
+		Name:   "web",
+		Labels: map[string]string{"app": "web", "managed-by": "terraform", "team": "web"},
+	}
+
+	m := flattenMetadata(meta, nil, config)[0]
+	expected := map[string]string{"app": "web", "team": "web"}
+	if !reflect.DeepEqual(m["labels"], expected) {
+		t.Fatalf("Unexpected labels.\nExpected: %#v\nGiven:    %#v", expected, m["labels"])
+	}
+}
+
+func TestFlattenMetadata_configuredDefaultKeys(t *testing.T) {
+	config := &metadataConfig{
+		defaultLabels: map[string]string{"managed-by": "terraform", "team": "platform"},
+	}
+	meta := metav1.ObjectMeta{
+		Name:   "web",
+		Labels: map[string]string{"app": "web", "managed-by": "terraform", "team": "platform"},
+	}
+	labels := map[string]interface{}{"app": "web", "team": "platform"}
+
+	cases := []struct {
+		Name   string
+		Schema map[string]*schema.Schema
+		Raw    map[string]interface{}
+		Prefix []string
+	}{
+		{
+			"object",
+			resourceKubernetesConfigMap().Schema,
+			map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "web", "labels": labels}},
+			},
+			nil,
+		},
+		{
+			"pod template",
+			resourceKubernetesDeployment().Schema,
+			map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "web"}},
+				"spec": []interface{}{map[string]interface{}{
+					"template": []interface{}{map[string]interface{}{
+						"metadata": []interface{}{map[string]interface{}{"labels": labels}},
+					}},
+				}},
+			},
+			[]string{"spec.0.template.0."},
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, tc.Schema, tc.Raw)
+		m := flattenMetadata(meta, d, config, tc.Prefix...)[0]
+		expected := map[string]string{"app": "web", "team": "platform"}
+		if !reflect.DeepEqual(m["labels"], expected) {
+			t.Fatalf("Unexpected labels of %s.\nExpected: %#v\nGiven:    %#v", tc.Name, expected, m["labels"])
+		}
+	}
+}
+
+func TestFlattenMetadata_ignoredKeys(t *testing.T) {
+	config := &metadataConfig{
+		ignoreAnnotations: []*regexp.Regexp{regexp.MustCompile(`^sidecar\.istio\.io/`)},
+		ignoreLabels:      []*regexp.Regexp{regexp.MustCompile(`^argocd\.argoproj\.io/`)},
+	}
+
+	meta := metav1.ObjectMeta{
+		Name: "web",
+		Annotations: map[string]string{
+			"app":                        "web",
+			"deployment.kubernetes.io/x": "1",
+			"sidecar.istio.io/status":    "injected",
+		},
+		Labels: map[string]string{
+			"app":                          "web",
+			"argocd.argoproj.io/instance":  "web",
+			"not.argocd.argoproj.io/owner": "team",
+		},
+	}
+
+	m := flattenMetadata(meta, nil, config)[0]
+	expectedAnnotations := map[string]string{"app": "web"}
+	if !reflect.DeepEqual(m["annotations"], expectedAnnotations) {
+		t.Fatalf("Unexpected annotations.\nExpected: %#v\nGiven:    %#v", expectedAnnotations, m["annotations"])
+	}
+	expectedLabels := map[string]string{"app": "web", "not.argocd.argoproj.io/owner": "team"}
+	if !reflect.DeepEqual(m["labels"], expectedLabels) {
+		t.Fatalf("Unexpected labels.\nExpected: %#v\nGiven:    %#v", expectedLabels, m["labels"])
+	}
+}
+
+func TestKeepIgnoredMetadata_podTemplate(t *testing.T) {
+	config := &metadataConfig{
+		ignoreAnnotations: []*regexp.Regexp{regexp.MustCompile(`^sidecar\.istio\.io/`)},
+	}
+	d := schema.TestResourceDataRaw(t, resourceKubernetesDeployment().Schema, map[string]interface{}{
+		"metadata": []interface{}{map[string]interface{}{"name": "web"}},
+		"spec": []interface{}{map[string]interface{}{
+			"template": []interface{}{map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{
+					"annotations": map[string]interface{}{"app": "api"},
+				}},
+			}},
+		}},
+	})
+	live := metav1.ObjectMeta{
+		Annotations: map[string]string{
+			"app":                               "web",
+			"kubectl.kubernetes.io/restartedAt": "2019-06-01T10:00:00Z",
+			"removed":                           "true",
+			"sidecar.istio.io/status":           "injected",
+		},
+	}
+
+	spec := expandDeploymentSpec(d.Get("spec").([]interface{}), d, config)
+	keepIgnoredMetadata(&spec.Template.ObjectMeta, live, config)
+	data, err := strategicMergePatchReplacing(map[string]interface{}{"spec": spec}, "spec.template")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var patch struct {
+		Spec struct {
+			Template struct {
+				Metadata metav1.ObjectMeta `json:"metadata"`
+			} `json:"template"`
+		} `json:"spec"`
+	}
+	if err := json.Unmarshal(data, &patch); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"app":                               "api",
+		"kubectl.kubernetes.io/restartedAt": "2019-06-01T10:00:00Z",
+		"sidecar.istio.io/status":           "injected",
+	}
+	if given := patch.Spec.Template.Metadata.Annotations; !reflect.DeepEqual(given, expected) {
+		t.Fatalf("Unexpected annotations of the replaced pod template.\nExpected: %#v\nGiven:    %#v", expected, given)
+	}
+}
+
+func TestPatchMetadata(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   *metadataConfig
+		State    map[string]string
+		Metadata map[string]interface{}
+		Expected PatchOperations
+	}{
+		{
+			"ignored keys",
+			&metadataConfig{
+				ignoreAnnotations: []*regexp.Regexp{regexp.MustCompile(`^sidecar\.istio\.io/`)},
+				ignoreLabels:      []*regexp.Regexp{regexp.MustCompile(`^argocd\.argoproj\.io/`)},
+			},
+			map[string]string{
+				"metadata.0.annotations.%":                       "2",
+				"metadata.0.annotations.app":                     "web",
+				"metadata.0.annotations.sidecar.istio.io/status": "injected",
+				"metadata.0.labels.%":                            "1",
+				"metadata.0.labels.app":                          "web",
+			},
+			map[string]interface{}{
+				"annotations": map[string]interface{}{"app": "api"},
+				"labels":      map[string]interface{}{"app": "web", "argocd.argoproj.io/instance": "web"},
+			},
+			PatchOperations{
+				&ReplaceOperation{Path: "/metadata/annotations/app", Value: "api"},
+			},
+		},
+		{
+			"unchanged defaults",
+			&metadataConfig{
+				defaultLabels: map[string]string{"managed-by": "terraform"},
+			},
+			map[string]string{
+				"metadata.0.labels.%":   "1",
+				"metadata.0.labels.app": "web",
+			},
+			map[string]interface{}{
+				"labels": map[string]interface{}{"app": "api"},
+			},
+			PatchOperations{
+				&ReplaceOperation{Path: "/metadata/labels/app", Value: "api"},
+			},
+		},
+		{
+			"changed default",
+			&metadataConfig{
+				defaultLabels: map[string]string{"team": "platform"},
+			},
+			map[string]string{
+				"metadata.0.labels.%":    "2",
+				"metadata.0.labels.app":  "web",
+				"metadata.0.labels.team": "web",
+			},
+			map[string]interface{}{
+				"labels": map[string]interface{}{"app": "web"},
+			},
+			PatchOperations{
+				&ReplaceOperation{Path: "/metadata/labels/team", Value: "platform"},
+			},
+		},
+		{
+			"first label",
+			&metadataConfig{
+				defaultLabels: map[string]string{"managed-by": "terraform"},
+			},
+			map[string]string{},
+			map[string]interface{}{
+				"labels": map[string]interface{}{"app": "web"},
+			},
+			PatchOperations{
+				&AddMapOperation{Path: "/metadata/labels", Value: map[string]interface{}{"app": "web", "managed-by": "terraform"}},
+			},
+		},
+		{
+			"ignored label only",
+			&metadataConfig{
+				ignoreLabels: []*regexp.Regexp{regexp.MustCompile(`^argocd\.argoproj\.io/`)},
+			},
+			map[string]string{},
+			map[string]interface{}{
+				"labels": map[string]interface{}{"argocd.argoproj.io/instance": "api"},
+			},
+			PatchOperations{},
+		},
+	}
+
+	for _, tc := range cases {
+		ops := testPatchMetadata(t, &providerMeta{metadata: tc.Config}, tc.State, tc.Metadata)
+		if !ops.Equal(tc.Expected) {
+			t.Fatalf("Unexpected patch operations for %s.\nExpected: %s\nGiven:    %s", tc.Name, tc.Expected, ops)
+		}
+	}
+}
+
+// testPatchMetadata returns the patch operations of the metadata of a config
+// map going from the given state to the given metadata
+func testPatchMetadata(t *testing.T, meta *providerMeta, state map[string]string, metadata map[string]interface{}) PatchOperations {
+	r := resourceKubernetesConfigMap()
+	s := &terraform.InstanceState{
+		ID: "default/web",
+		Attributes: map[string]string{
+			"metadata.#":           "1",
+			"metadata.0.name":      "web",
+			"metadata.0.namespace": "default",
+		},
+	}
+	for k, v := range state {
+		s.Attributes[k] = v
+	}
+	metadata["name"] = "web"
+	metadata["namespace"] = "default"
+
+	c, err := config.NewRawConfig(map[string]interface{}{
+		"metadata": []interface{}{metadata},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := r.Diff(s, terraform.NewResourceConfig(c), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ops PatchOperations
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		ops = patchMetadata("metadata.0.", "/metadata/", d, meta)
+		return nil
+	}
+	if _, err := r.Apply(s, diff, meta); err != nil {
+		t.Fatal(err)
+	}
+	return ops
+}
@@ -1,7 +1,11 @@
 package kubernetes
 
 import (
+	"encoding/json"
 	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -13,7 +17,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-google/google"
 	api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -57,6 +60,123 @@ func TestProvider_configure(t *testing.T) {
 	}
 }
 
+func TestProvider_configureRaw(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	raw, err := ioutil.ReadFile("test-fixtures/kube-config-static.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{
+		"config_path": "test-fixtures/missing.yaml",
+		"config_raw":  string(raw),
+	})
+	cfg, err := tryLoadingConfigFile(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg == nil {
+		t.Fatal("Expected config_raw to be loaded")
+	}
+	if cfg.Host != "https://127.0.0.2" || cfg.BearerToken != "dummy" {
+		t.Fatalf("Unexpected host %q or token %q", cfg.Host, cfg.BearerToken)
+	}
+}
+
+func TestProvider_configurePaths(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	cases := map[string]string{
+		"gcp":    "https://127.0.0.1",
+		"static": "https://127.0.0.2",
+	}
+	for ctx, host := range cases {
+		d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{
+			"config_paths": []interface{}{
+				"test-fixtures/missing.yaml",
+				"test-fixtures/kube-config.yaml",
+				"test-fixtures/kube-config-static.yaml",
+			},
+			"config_context": ctx,
+		})
+		cfg, err := tryLoadingConfigFile(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cfg == nil {
+			t.Fatalf("Expected config_paths to be loaded for context %q", ctx)
+		}
+		if cfg.Host != host {
+			t.Fatalf("Unexpected host for context %q.\nExpected: %q\nGiven:    %q", ctx, host, cfg.Host)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{
+		"config_paths": []interface{}{"test-fixtures/missing.yaml"},
+	})
+	cfg, err := tryLoadingConfigFile(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg != nil {
+		t.Fatalf("Expected no config when none of the files exists, given %#v", cfg)
+	}
+}
+
+func TestProvider_configureClient(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			// An etcd leader change
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(metav1.Status{
+				TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+				Status:   metav1.StatusFailure,
+				Message:  "etcdserver: leader changed",
+				Code:     http.StatusInternalServerError,
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(api.Namespace{
+			TypeMeta:   metav1.TypeMeta{Kind: "Namespace", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		})
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{
+		"host":             server.URL,
+		"load_config_file": false,
+		"qps":              50,
+		"burst":            100,
+		"request_timeout":  "10s",
+	})
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := meta.(*providerMeta).conn
+
+	if qps := conn.CoreV1().RESTClient().GetRateLimiter().QPS(); qps != 50 {
+		t.Fatalf("Unexpected QPS.\nExpected: %v\nGiven:    %v", 50, qps)
+	}
+	ns, err := conn.CoreV1().Namespaces().Get("default", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected the request to be retried: %s", err)
+	}
+	if ns.Name != "default" || calls != 2 {
+		t.Fatalf("Unexpected namespace %q after %d calls", ns.Name, calls)
+	}
+}
+
 func unsetEnv(t *testing.T) func() {
 	e := getEnv()
 
@@ -66,6 +186,12 @@ func unsetEnv(t *testing.T) func() {
 	if err := os.Unsetenv("KUBE_CONFIG"); err != nil {
 		t.Fatalf("Error unsetting env var KUBE_CONFIG: %s", err)
 	}
+	if err := os.Unsetenv("KUBE_CONFIG_PATHS"); err != nil {
+		t.Fatalf("Error unsetting env var KUBE_CONFIG_PATHS: %s", err)
+	}
+	if err := os.Unsetenv("KUBE_CONFIG_RAW"); err != nil {
+		t.Fatalf("Error unsetting env var KUBE_CONFIG_RAW: %s", err)
+	}
 	if err := os.Unsetenv("KUBE_CTX"); err != nil {
 		t.Fatalf("Error unsetting env var KUBE_CTX: %s", err)
 	}
@@ -101,6 +227,12 @@ func unsetEnv(t *testing.T) func() {
 		if err := os.Setenv("KUBECONFIG", e.Config); err != nil {
 			t.Fatalf("Error resetting env var KUBECONFIG: %s", err)
 		}
+		if err := os.Setenv("KUBE_CONFIG_PATHS", e.ConfigPaths); err != nil {
+			t.Fatalf("Error resetting env var KUBE_CONFIG_PATHS: %s", err)
+		}
+		if err := os.Setenv("KUBE_CONFIG_RAW", e.ConfigRaw); err != nil {
+			t.Fatalf("Error resetting env var KUBE_CONFIG_RAW: %s", err)
+		}
 		if err := os.Setenv("KUBE_CTX", e.Config); err != nil {
 			t.Fatalf("Error resetting env var KUBE_CTX: %s", err)
 		}
@@ -133,6 +265,8 @@ func unsetEnv(t *testing.T) func() {
 
 func getEnv() *currentEnv {
 	e := &currentEnv{
+		ConfigPaths:       os.Getenv("KUBE_CONFIG_PATHS"),
+		ConfigRaw:         os.Getenv("KUBE_CONFIG_RAW"),
 		Ctx:               os.Getenv("KUBE_CTX_CLUSTER"),
 		CtxAuthInfo:       os.Getenv("KUBE_CTX_AUTH_INFO"),
 		CtxCluster:        os.Getenv("KUBE_CTX_CLUSTER"),
@@ -247,7 +381,7 @@ func getFirstNode() (api.Node, error) {
 	if meta == nil {
 		return api.Node{}, errors.New("Provider not initialized, unable to get cluster node")
 	}
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 	resp, err := conn.CoreV1().Nodes().List(metav1.ListOptions{})
 	if err != nil {
 		return api.Node{}, err
@@ -262,6 +396,8 @@ func getFirstNode() (api.Node, error) {
 
 type currentEnv struct {
 	Config            string
+	ConfigPaths       string
+	ConfigRaw         string
 	Ctx               string
 	CtxAuthInfo       string
 	CtxCluster        string
@@ -0,0 +1,132 @@
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+const (
+	// retryMaxRetries is how many times a request is retried at most
+	retryMaxRetries = 5
+	// retryBaseDelay is the delay before the first retry, doubled on each
+	// retry up to retryMaxDelay
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 8 * time.Second
+)
+
+// idempotentMethods are the methods which can be retried after a server
+// error, the request may have been processed already
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// retryRoundTripper retries the requests which failed with a transient
+// error, e.g. when the API server is throttling the requests, while etcd
+// elects a new leader or on a conflicting update of an object
+type retryRoundTripper struct {
+	rt         http.RoundTripper
+	maxRetries int
+	delay      func(retry int) time.Duration
+}
+
+func newRetryRoundTripper(rt http.RoundTripper) http.RoundTripper {
+	return &retryRoundTripper{rt: rt, maxRetries: retryMaxRetries, delay: retryDelay}
+}
+
+// retryDelay backs off exponentially
+func retryDelay(retry int) time.Duration {
+	delay := retryBaseDelay << uint(retry)
+	if delay <= 0 || delay > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return delay
+}
+
+func (t *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	for retry := 0; ; retry++ {
+		resp, err := t.rt.RoundTrip(req)
+		if err != nil || retry >= t.maxRetries || !isRetryableResponse(req, resp) {
+			return resp, err
+		}
+
+		// The body was consumed by the previous attempt
+		if req.Body != nil {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req = utilnet.CloneRequest(req)
+			req.Body = body
+		}
+
+		delay := t.delay(retry)
+		log.Printf("[DEBUG] Retrying %s %s in %s, received %q", req.Method, req.URL.Path, delay, resp.Status)
+		// Drain the body so the connection can be reused
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// isRetryableResponse returns whether the request failed with an error
+// which may go away on its own
+func isRetryableResponse(req *http.Request, resp *http.Response) bool {
+	// The REST client retries the responses with a Retry-After header
+	// itself, they would be retried twice otherwise
+	if resp.Header.Get("Retry-After") != "" {
+		return false
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		// The request was rejected before being processed
+		return true
+	case http.StatusConflict:
+		// A conflicting update isn't applied. Only the conflicts of patches
+		// resolve on retry as they don't carry a resource version, the
+		// stale resource version of an update would conflict again.
+		return req.Method == http.MethodPatch && statusReason(resp) == metav1.StatusReasonConflict
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotentMethods[req.Method]
+	}
+	return false
+}
+
+// statusReason returns the reason of the Status object in the body of the
+// response, leaving the body to be read again
+func statusReason(resp *http.Response) metav1.StatusReason {
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return metav1.StatusReasonUnknown
+	}
+
+	status := metav1.Status{}
+	if err := json.Unmarshal(body, &status); err != nil {
+		return metav1.StatusReasonUnknown
+	}
+	return status.Reason
+}
@@ -0,0 +1,102 @@
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRetryRoundTripper(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Method        string
+		Responses     []int
+		Header        http.Header
+		Reason        metav1.StatusReason
+		ExpectedCode  int
+		ExpectedCalls int
+	}{
+		{"server errors of a GET", http.MethodGet, []int{503, 500, 200}, nil, "", 200, 3},
+		{"server error of a POST", http.MethodPost, []int{503, 201}, nil, "", 503, 1},
+		{"throttled POST", http.MethodPost, []int{429, 201}, nil, "", 201, 2},
+		{"conflicting PATCH", http.MethodPatch, []int{409, 200}, nil, metav1.StatusReasonConflict, 200, 2},
+		{"conflicting PUT", http.MethodPut, []int{409, 200}, nil, metav1.StatusReasonConflict, 409, 1},
+		{"already existing object", http.MethodPost, []int{409, 201}, nil, metav1.StatusReasonAlreadyExists, 409, 1},
+		{"Retry-After header", http.MethodGet, []int{429, 200}, http.Header{"Retry-After": {"1"}}, "", 429, 1},
+		{"not found", http.MethodGet, []int{404, 200}, nil, metav1.StatusReasonNotFound, 404, 1},
+		{"too many retries", http.MethodGet, []int{503, 503, 503, 503, 200}, nil, "", 503, 4},
+	}
+
+	for _, tc := range cases {
+		var calls int
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+
+			code := tc.Responses[calls]
+			calls++
+			for k, v := range tc.Header {
+				w.Header()[k] = v
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(code)
+			json.NewEncoder(w).Encode(metav1.Status{
+				TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+				Reason:   tc.Reason,
+				Code:     int32(code),
+			})
+		}))
+
+		client := &http.Client{
+			Transport: &retryRoundTripper{
+				rt:         http.DefaultTransport,
+				maxRetries: 3,
+				delay:      func(int) time.Duration { return time.Millisecond },
+			},
+		}
+		req, _ := http.NewRequest(tc.Method, server.URL, bytes.NewReader([]byte(`{"spec":{}}`)))
+		resp, err := client.Do(req)
+		if err != nil {
+			server.Close()
+			t.Fatalf("Unexpected error on %s: %s", tc.Name, err)
+		}
+
+		status := metav1.Status{}
+		err = json.NewDecoder(resp.Body).Decode(&status)
+		resp.Body.Close()
+		server.Close()
+		if err != nil {
+			t.Fatalf("Expected the body of the last response to be readable on %s: %s", tc.Name, err)
+		}
+		if resp.StatusCode != tc.ExpectedCode || calls != tc.ExpectedCalls {
+			t.Fatalf("Unexpected response on %s.\nExpected: %d after %d calls\nGiven:    %d after %d calls",
+				tc.Name, tc.ExpectedCode, tc.ExpectedCalls, resp.StatusCode, calls)
+		}
+		for _, body := range bodies {
+			if body != `{"spec":{}}` {
+				t.Fatalf("Expected the body to be sent again on %s, given %q", tc.Name, body)
+			}
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	expected := []time.Duration{
+		500 * time.Millisecond, time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 8 * time.Second,
+	}
+	for i, e := range expected {
+		if d := retryDelay(i); d != e {
+			t.Fatalf("Unexpected delay of retry %d.\nExpected: %s\nGiven:    %s", i, e, d)
+		}
+	}
+	if d := retryDelay(100); d != retryMaxDelay {
+		t.Fatalf("Expected the delay to be capped at %s, given %s", retryMaxDelay, d)
+	}
+}
@@ -0,0 +1,203 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/certificates/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesCertificateSigningRequest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesCertificateSigningRequestCreate,
+		Read:   resourceKubernetesCertificateSigningRequestRead,
+		Update: resourceKubernetesCertificateSigningRequestUpdate,
+		Delete: resourceKubernetesCertificateSigningRequestDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("certificate signing request", true),
+			"auto_approve": {
+				Type:        schema.TypeBool,
+				Description: "Automatically approve the certificate signing request through the approval subresource. Otherwise the request has to be approved by someone else before the timeout expires.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Description: "The PEM encoded certificate issued for the request.",
+				Computed:    true,
+			},
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the certificate request. More info: https://kubernetes.io/docs/tasks/tls/managing-tls-in-a-cluster/",
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request": {
+							Type:         schema.TypeString,
+							Description:  "The PEM encoded PKCS#10 certificate request. The request is base64 encoded by the provider, like the data of a secret.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateCertificateRequest,
+						},
+						"usages": {
+							Type:        schema.TypeSet,
+							Description: "The usage contexts the issued certificate will be valid for, e.g. `client auth`, `server auth`, `digital signature` or `key encipherment`.",
+							Optional:    true,
+							ForceNew:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAttributeValueIsIn(certificateKeyUsages),
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesCertificateSigningRequestCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
+	csr := api.CertificateSigningRequest{
+		ObjectMeta: metadata,
+		Spec:       expandCertificateSigningRequestSpec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new certificate signing request: %s", redacted(csr))
+	out, err := conn.CertificatesV1beta1().CertificateSigningRequests().Create(&csr)
+	if err != nil {
+		return fmt.Errorf("Failed to create certificate signing request: %s", err)
+	}
+	log.Printf("[INFO] Submitted new certificate signing request: %s", redacted(out))
+	d.SetId(out.Name)
+
+	if d.Get("auto_approve").(bool) {
+		out.Status.Conditions = append(out.Status.Conditions, api.CertificateSigningRequestCondition{
+			Type:    api.CertificateApproved,
+			Reason:  "TerraformAutoApprove",
+			Message: "This certificate signing request was approved by Terraform",
+		})
+		log.Printf("[INFO] Approving certificate signing request %s", out.Name)
+		_, err = conn.CertificatesV1beta1().CertificateSigningRequests().UpdateApproval(out)
+		if err != nil {
+			return fmt.Errorf("Failed to approve certificate signing request: %s", err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:  []string{"Issued"},
+		Pending: []string{"Pending", "Approved"},
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Refresh: func() (interface{}, string, error) {
+			out, err := conn.CertificatesV1beta1().CertificateSigningRequests().Get(d.Id(), meta_v1.GetOptions{})
+			if err != nil {
+				log.Printf("[ERROR] Received error: %s", redacted(err))
+				return out, "Error", err
+			}
+
+			state := certificateSigningRequestState(out)
+			log.Printf("[DEBUG] Certificate signing request %s state: %s", out.Name, state)
+			if state == "Denied" {
+				return out, state, fmt.Errorf("Certificate signing request %s was denied", out.Name)
+			}
+			return out, state, nil
+		},
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Certificate signing request %s issued", out.Name)
+
+	return resourceKubernetesCertificateSigningRequestRead(d, meta)
+}
+
+func resourceKubernetesCertificateSigningRequestRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Reading certificate signing request %s", name)
+	csr, err := conn.CertificatesV1beta1().CertificateSigningRequests().Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		// Issued requests are garbage collected by the controller manager
+		// after an hour, the certificate stays valid and is kept in the state
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 && d.Get("certificate").(string) != "" {
+			log.Printf("[INFO] Certificate signing request %s was cleaned up, keeping the issued certificate", name)
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+		return err
+	}
+	log.Printf("[INFO] Received certificate signing request: %s", redacted(csr))
+	err = d.Set("metadata", flattenMetadata(csr.ObjectMeta, d, meta.(*providerMeta).metadata))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("spec", flattenCertificateSigningRequestSpec(csr.Spec))
+	if err != nil {
+		return err
+	}
+
+	d.Set("certificate", string(csr.Status.Certificate))
+
+	return nil
+}
+
+func resourceKubernetesCertificateSigningRequestUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	log.Printf("[INFO] Updating certificate signing request %q: %s", name, redacted(ops))
+	var out *api.CertificateSigningRequest
+	err := sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.CertificatesV1beta1().CertificateSigningRequests().Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			log.Printf("[INFO] Certificate signing request %s was cleaned up, nothing to update", name)
+			return nil
+		}
+		return fmt.Errorf("Failed to update certificate signing request: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated certificate signing request: %s", redacted(out))
+
+	return resourceKubernetesCertificateSigningRequestRead(d, meta)
+}
+
+func resourceKubernetesCertificateSigningRequestDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting certificate signing request: %q", name)
+	err := conn.CertificatesV1beta1().CertificateSigningRequests().Delete(name, &meta_v1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); !ok || statusErr.ErrStatus.Code != 404 {
+			return err
+		}
+	}
+	log.Printf("[INFO] Certificate signing request %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
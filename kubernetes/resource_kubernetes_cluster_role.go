@@ -9,7 +9,6 @@ import (
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/api/rbac/v1"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesClusterRole() *schema.Resource {
@@ -39,44 +38,44 @@ func resourceKubernetesClusterRole() *schema.Resource {
 }
 
 func resourceKubernetesClusterRoleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	binding := &v1.ClusterRole{
 		ObjectMeta: metadata,
 		Rules: expandRBACRules(d.Get("rule").([]interface{})),
 	}
-	log.Printf("[INFO] Creating new ClusterRole: %#v", binding)
+	log.Printf("[INFO] Creating new ClusterRole: %s", redacted(binding))
 	binding, err := conn.Rbac().ClusterRoles().Create(binding)
 
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new ClusterRole: %#v", binding)
+	log.Printf("[INFO] Submitted new ClusterRole: %s", redacted(binding))
 	d.SetId(metadata.Name)
 
 	return resourceKubernetesClusterRoleRead(d, meta)
 }
 
 func resourceKubernetesClusterRoleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Reading ClusterRole %s", name)
 	role, err := conn.Rbac().ClusterRoles().Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
 
-	log.Printf("[INFO] Received ClusterRole: %#v", role)
-	err = d.Set("metadata", flattenMetadata(role.ObjectMeta))
+	log.Printf("[INFO] Received ClusterRole: %s", redacted(role))
+	err = d.Set("metadata", flattenMetadata(role.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
 
 	flattenedRules := flattenRBACRules(role.Rules)
-	log.Printf("[DEBUG] Flattened ClusterRole ruleRef: %#v", flattenedRules)
+	log.Printf("[DEBUG] Flattened ClusterRole ruleRef: %s", redacted(flattenedRules))
 	err = d.Set("rule", flattenedRules)
 	if err != nil {
 		return err
@@ -86,35 +85,35 @@ func resourceKubernetesClusterRoleRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesClusterRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("rule") {
 		diffOps := patchRbacRule(d)
 		ops = append(ops, diffOps...)
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating ClusterRole %q: %v", name, string(data))
-	out, err := conn.Rbac().ClusterRoles().Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating ClusterRole %q: %s", name, redacted(ops))
+	var out *v1.ClusterRole
+	err := sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.Rbac().ClusterRoles().Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update ClusterRole: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated ClusterRole: %#v", out)
+	log.Printf("[INFO] Submitted updated ClusterRole: %s", redacted(out))
 	d.SetId(out.ObjectMeta.Name)
 
 	return resourceKubernetesClusterRoleRead(d, meta)
 }
 
 func resourceKubernetesClusterRoleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
-	log.Printf("[INFO] Deleting ClusterRole: %#v", name)
+	log.Printf("[INFO] Deleting ClusterRole: %q", name)
 	err := conn.Rbac().ClusterRoles().Delete(name, &meta_v1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -126,7 +125,7 @@ func resourceKubernetesClusterRoleDelete(d *schema.ResourceData, meta interface{
 }
 
 func resourceKubernetesClusterRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Checking ClusterRole %s", name)
@@ -135,7 +134,7 @@ func resourceKubernetesClusterRoleExists(d *schema.ResourceData, meta interface{
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
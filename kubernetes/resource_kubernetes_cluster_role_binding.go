@@ -10,7 +10,6 @@ import (
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/api/rbac/v1"
 	//kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesClusterRoleBinding() *schema.Resource {
@@ -49,52 +48,52 @@ func resourceKubernetesClusterRoleBinding() *schema.Resource {
 }
 
 func resourceKubernetesClusterRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	binding := &v1.ClusterRoleBinding{
 		ObjectMeta: metadata,
 		RoleRef:    expandRBACRoleRef(d.Get("role_ref").(interface{})),
 		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
 	}
-	log.Printf("[INFO] Creating new ClusterRoleBinding: %#v", binding)
+	log.Printf("[INFO] Creating new ClusterRoleBinding: %s", redacted(binding))
 	binding, err := conn.Rbac().ClusterRoleBindings().Create(binding)
 
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new ClusterRoleBinding: %#v", binding)
+	log.Printf("[INFO] Submitted new ClusterRoleBinding: %s", redacted(binding))
 	d.SetId(metadata.Name)
 
 	return resourceKubernetesClusterRoleBindingRead(d, meta)
 }
 
 func resourceKubernetesClusterRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Reading ClusterRoleBinding %s", name)
 	binding, err := conn.Rbac().ClusterRoleBindings().Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
 
-	log.Printf("[INFO] Received ClusterRoleBinding: %#v", binding)
-	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta))
+	log.Printf("[INFO] Received ClusterRoleBinding: %s", redacted(binding))
+	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
 
 	flattenedRef := flattenRBACRoleRef(binding.RoleRef)
-	log.Printf("[DEBUG] Flattened ClusterRoleBinding roleRef: %#v", flattenedRef)
+	log.Printf("[DEBUG] Flattened ClusterRoleBinding roleRef: %s", redacted(flattenedRef))
 	err = d.Set("role_ref", flattenedRef)
 	if err != nil {
 		return err
 	}
 
 	flattenedSubjects := flattenRBACSubjects(binding.Subjects)
-	log.Printf("[DEBUG] Flattened ClusterRoleBinding subjects: %#v", flattenedSubjects)
+	log.Printf("[DEBUG] Flattened ClusterRoleBinding subjects: %s", redacted(flattenedSubjects))
 	err = d.Set("subject", flattenedSubjects)
 	if err != nil {
 		return err
@@ -104,35 +103,35 @@ func resourceKubernetesClusterRoleBindingRead(d *schema.ResourceData, meta inter
 }
 
 func resourceKubernetesClusterRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("subject") {
 		diffOps := patchRbacSubject(d)
 		ops = append(ops, diffOps...)
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating ClusterRoleBinding %q: %v", name, string(data))
-	out, err := conn.Rbac().ClusterRoleBindings().Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating ClusterRoleBinding %q: %s", name, redacted(ops))
+	var out *v1.ClusterRoleBinding
+	err := sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.Rbac().ClusterRoleBindings().Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update ClusterRoleBinding: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated ClusterRoleBinding: %#v", out)
+	log.Printf("[INFO] Submitted updated ClusterRoleBinding: %s", redacted(out))
 	d.SetId(out.ObjectMeta.Name)
 
 	return resourceKubernetesClusterRoleBindingRead(d, meta)
 }
 
 func resourceKubernetesClusterRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
-	log.Printf("[INFO] Deleting ClusterRoleBinding: %#v", name)
+	log.Printf("[INFO] Deleting ClusterRoleBinding: %q", name)
 	err := conn.Rbac().ClusterRoleBindings().Delete(name, &meta_v1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -144,7 +143,7 @@ func resourceKubernetesClusterRoleBindingDelete(d *schema.ResourceData, meta int
 }
 
 func resourceKubernetesClusterRoleBindingExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Checking ClusterRoleBinding %s", name)
@@ -153,7 +152,7 @@ func resourceKubernetesClusterRoleBindingExists(d *schema.ResourceData, meta int
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	api "k8s.io/api/rbac/v1"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func TestAccKubernetesClusterRole(t *testing.T) {
@@ -93,7 +92,7 @@ func TestAccKubernetesClusterRole_importBasic(t *testing.T) {
 }
 
 func testAccCheckKubernetesClusterRoleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*kubernetes.Clientset)
+	conn := testAccProvider.Meta().(*providerMeta).conn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "kubernetes_cluster_role" {
@@ -118,7 +117,7 @@ func testAccCheckKubernetesClusterRoleExists(n string, obj *api.ClusterRole) res
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*kubernetes.Clientset)
+		conn := testAccProvider.Meta().(*providerMeta).conn
 		name := rs.Primary.ID
 		resp, err := conn.Rbac().ClusterRoles().Get(name, meta_v1.GetOptions{})
 		if err != nil {
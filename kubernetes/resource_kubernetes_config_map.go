@@ -9,7 +9,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesConfigMap() *schema.Resource {
@@ -35,26 +34,26 @@ func resourceKubernetesConfigMap() *schema.Resource {
 }
 
 func resourceKubernetesConfigMapCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	cfgMap := api.ConfigMap{
 		ObjectMeta: metadata,
 		Data:       expandStringMap(d.Get("data").(map[string]interface{})),
 	}
-	log.Printf("[INFO] Creating new config map: %#v", cfgMap)
+	log.Printf("[INFO] Creating new config map: %s", redacted(cfgMap))
 	out, err := conn.CoreV1().ConfigMaps(metadata.Namespace).Create(&cfgMap)
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new config map: %#v", out)
+	log.Printf("[INFO] Submitted new config map: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesConfigMapRead(d, meta)
 }
 
 func resourceKubernetesConfigMapRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -63,11 +62,11 @@ func resourceKubernetesConfigMapRead(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] Reading config map %s", name)
 	cfgMap, err := conn.CoreV1().ConfigMaps(namespace).Get(name, metav1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received config map: %#v", cfgMap)
-	err = d.Set("metadata", flattenMetadata(cfgMap.ObjectMeta))
+	log.Printf("[INFO] Received config map: %s", redacted(cfgMap))
+	err = d.Set("metadata", flattenMetadata(cfgMap.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
@@ -77,42 +76,42 @@ func resourceKubernetesConfigMapRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesConfigMapUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("data") {
 		oldV, newV := d.GetChange("data")
 		diffOps := diffStringMap("/data/", oldV.(map[string]interface{}), newV.(map[string]interface{}))
 		ops = append(ops, diffOps...)
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating config map %q: %v", name, string(data))
-	out, err := conn.CoreV1().ConfigMaps(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating config map %q: %s", name, redacted(ops))
+	var out *api.ConfigMap
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.CoreV1().ConfigMaps(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update Config Map: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated config map: %#v", out)
+	log.Printf("[INFO] Submitted updated config map: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesConfigMapRead(d, meta)
 }
 
 func resourceKubernetesConfigMapDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Deleting config map: %#v", name)
+	log.Printf("[INFO] Deleting config map: %q", name)
 	err = conn.CoreV1().ConfigMaps(namespace).Delete(name, &metav1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -125,7 +124,7 @@ func resourceKubernetesConfigMapDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesConfigMapExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -138,7 +137,7 @@ func resourceKubernetesConfigMapExists(d *schema.ResourceData, meta interface{})
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	api "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func TestAccKubernetesConfigMap_basic(t *testing.T) {
@@ -203,7 +202,7 @@ func testAccCheckConfigMapData(m *api.ConfigMap, expected map[string]string) res
 }
 
 func testAccCheckKubernetesConfigMapDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*kubernetes.Clientset)
+	conn := testAccProvider.Meta().(*providerMeta).conn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "kubernetes_config_map" {
@@ -231,7 +230,7 @@ func testAccCheckKubernetesConfigMapExists(n string, obj *api.ConfigMap) resourc
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*kubernetes.Clientset)
+		conn := testAccProvider.Meta().(*providerMeta).conn
 		namespace, name, err := idParts(rs.Primary.ID)
 		if err != nil {
 			return err
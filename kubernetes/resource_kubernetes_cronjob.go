@@ -4,13 +4,13 @@ import (
 	"fmt"
 	"log"
 	"encoding/json"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1beta1 "k8s.io/api/batch/v1beta1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesCronJob() *schema.Resource {
@@ -23,9 +23,13 @@ func resourceKubernetesCronJob() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("CronJob", false),
+			"metadata":                  namespacedMetadataSchema("CronJob", false),
+			"delete_propagation_policy": deletePropagationPolicySchema("cron job"),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec of the pod owned by the cluster",
@@ -41,27 +45,27 @@ func resourceKubernetesCronJob() *schema.Resource {
 }
 
 func resourceKubernetesCronJobCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	CronJob := &v1beta1.CronJob{
 		ObjectMeta: metadata,
-		Spec: expandCronJobSpec(d.Get("spec").([]interface{})),
+		Spec: expandCronJobSpec(d.Get("spec").([]interface{}), d, meta.(*providerMeta).metadata),
 	}
-	log.Printf("[INFO] Creating new CronJob: %#v", CronJob)
+	log.Printf("[INFO] Creating new CronJob: %s", redacted(CronJob))
 	CronJob, err := conn.BatchV1beta1().CronJobs(metadata.Namespace).Create(CronJob)
 
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new CronJob: %#v", CronJob)
+	log.Printf("[INFO] Submitted new CronJob: %s", redacted(CronJob))
 	d.SetId(buildId(CronJob.ObjectMeta))
 
 	return resourceKubernetesCronJobRead(d, meta)
 }
 
 func resourceKubernetesCronJobRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -71,18 +75,18 @@ func resourceKubernetesCronJobRead(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[INFO] Reading CronJob %s", name)
 	CronJob, err := conn.BatchV1beta1().CronJobs(namespace).Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
 
-	log.Printf("[INFO] Received CronJob: %#v", CronJob)
-	err = d.Set("metadata", flattenMetadata(CronJob.ObjectMeta))
+	log.Printf("[INFO] Received CronJob: %s", redacted(CronJob))
+	err = d.Set("metadata", flattenMetadata(CronJob.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
 
-	flattenedSpec := flattenCronJobSpec(CronJob.Spec)
-	log.Printf("[DEBUG] Flattened CronJob spec: %#v", flattenedSpec)
+	flattenedSpec := flattenCronJobSpec(CronJob.Spec, d, meta.(*providerMeta).metadata)
+	log.Printf("[DEBUG] Flattened CronJob spec: %s", redacted(flattenedSpec))
 	err = d.Set("spec", flattenedSpec)
 	if err != nil {
 		return err
@@ -92,42 +96,58 @@ func resourceKubernetesCronJobRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceKubernetesCronJobUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 	
 		namespace, name, err := idParts(d.Id())
 		if err != nil {
 			return err
 		}
 	
-		metadata := expandMetadata(d.Get("metadata").([]interface{}))
-		CronJob := &v1beta1.CronJob{
-			ObjectMeta: metadata,
-			Spec: expandCronJobSpec(d.Get("spec").([]interface{})),
+		// The metadata is patched key by key, leaving the ignored keys alone
+		ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+		if len(ops) > 0 {
+			log.Printf("[INFO] Updating metadata of CronJob %s: %s", d.Id(), redacted(ops))
+			err = sendJSONPatch(ops, func(data []byte) (err error) {
+				_, err = conn.BatchV1beta1().CronJobs(namespace).Patch(name, pkgApi.JSONPatchType, data)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("Failed to update CronJob metadata: %s", err)
+			}
 		}
 
-		data, err := json.Marshal(CronJob)
+		spec := expandCronJobSpec(d.Get("spec").([]interface{}), d, meta.(*providerMeta).metadata)
+		data, err := json.Marshal(map[string]interface{}{"spec": spec})
 		if err != nil {
 			return fmt.Errorf("Failed to marshal update operations: %s", err)
 		}
 	
-		log.Printf("[INFO] Updating CronJob %s: %s", d.Id(), CronJob)
+		log.Printf("[INFO] Updating CronJob %s: %s", d.Id(), redactedJSON(data))
 	
 		out, err := conn.BatchV1beta1().CronJobs(namespace).Patch(name, pkgApi.StrategicMergePatchType, data)
 		if err != nil {
 			return err
 		}
-		log.Printf("[INFO] Submitted updated CronJob: %#v", out)
+		log.Printf("[INFO] Submitted updated CronJob: %s", redacted(out))
 	
 		d.SetId(buildId(out.ObjectMeta))
 		return resourceKubernetesCronJobRead(d, meta)
 }
 
 func resourceKubernetesCronJobDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
-	log.Printf("[INFO] Deleting CronJob: %#v", name)
-	err = conn.BatchV1beta1().CronJobs(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	log.Printf("[INFO] Deleting CronJob: %q", name)
+	err = conn.BatchV1beta1().CronJobs(namespace).Delete(name, expandDeleteOptions(d))
+	if err != nil {
+		return err
+	}
+
+	err = waitForDeletion("CronJob", name, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := conn.BatchV1beta1().CronJobs(namespace).Get(name, meta_v1.GetOptions{})
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -138,7 +158,7 @@ func resourceKubernetesCronJobDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesCronJobExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	log.Printf("[INFO] Checking CronJob %s", name)
@@ -147,7 +167,7 @@ func resourceKubernetesCronJobExists(d *schema.ResourceData, meta interface{}) (
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
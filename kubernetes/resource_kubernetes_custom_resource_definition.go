@@ -0,0 +1,233 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	kubernetes "k8s.io/client-go/kubernetes"
+)
+
+const customResourceDefinitionsPath = "/apis/apiextensions.k8s.io/v1beta1/customresourcedefinitions"
+
+func resourceKubernetesCustomResourceDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesCustomResourceDefinitionCreate,
+		Read:   resourceKubernetesCustomResourceDefinitionRead,
+		Exists: resourceKubernetesCustomResourceDefinitionExists,
+		Update: resourceKubernetesCustomResourceDefinitionUpdate,
+		Delete: resourceKubernetesCustomResourceDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("custom resource definition", false),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec describes how the custom resources are served. More info: https://kubernetes.io/docs/tasks/access-kubernetes-api/extend-api-custom-resource-definitions/",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: customResourceDefinitionSpecFields(),
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesCustomResourceDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
+	spec, err := expandCustomResourceDefinitionSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return err
+	}
+	crd := customResourceDefinition{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}
+	crd.APIVersion = "apiextensions.k8s.io/v1beta1"
+	crd.Kind = "CustomResourceDefinition"
+
+	data, err := json.Marshal(crd)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Creating new custom resource definition: %s", redactedJSON(data))
+	out, err := conn.Discovery().RESTClient().Post().
+		AbsPath(customResourceDefinitionsPath).
+		Body(data).
+		DoRaw()
+	if err != nil {
+		return fmt.Errorf("Failed to create custom resource definition: %s", err)
+	}
+	log.Printf("[INFO] Submitted new custom resource definition: %s", redactedJSON(out))
+	created := customResourceDefinition{}
+	err = json.Unmarshal(out, &created)
+	if err != nil {
+		return fmt.Errorf("Failed to decode custom resource definition: %s", err)
+	}
+	d.SetId(created.Name)
+
+	stateConf := &resource.StateChangeConf{
+		Target:  []string{"Established"},
+		Pending: []string{"Pending"},
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Refresh: func() (interface{}, string, error) {
+			crd, err := getCustomResourceDefinition(conn, d.Id())
+			if err != nil {
+				log.Printf("[ERROR] Received error: %s", redacted(err))
+				return crd, "Error", err
+			}
+
+			state, message := customResourceDefinitionState(crd)
+			log.Printf("[DEBUG] Custom resource definition %s state: %s", d.Id(), state)
+			if state == "NamesNotAccepted" {
+				return crd, state, fmt.Errorf("The names of custom resource definition %s were not accepted: %s", d.Id(), message)
+			}
+			return crd, state, nil
+		},
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Custom resource definition %s established", d.Id())
+
+	return resourceKubernetesCustomResourceDefinitionRead(d, meta)
+}
+
+func resourceKubernetesCustomResourceDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Reading custom resource definition %s", name)
+	crd, err := getCustomResourceDefinition(conn, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+		return err
+	}
+	log.Printf("[INFO] Received custom resource definition: %s", redacted(crd))
+	err = d.Set("metadata", flattenMetadata(crd.ObjectMeta, d, meta.(*providerMeta).metadata))
+	if err != nil {
+		return err
+	}
+
+	spec, err := flattenCustomResourceDefinitionSpec(crd.Spec)
+	if err != nil {
+		return err
+	}
+	// Keep the formatting of the configuration when the schema hasn't drifted
+	validation := d.Get("spec.0.validation").(string)
+	if v, ok := spec[0].(map[string]interface{})["validation"].(string); ok && suppressEquivalentManifests("validation", validation, v, d) {
+		spec[0].(map[string]interface{})["validation"] = validation
+	}
+	err = d.Set("spec", spec)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesCustomResourceDefinitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	if d.HasChange("spec") {
+		spec, err := expandCustomResourceDefinitionSpec(d.Get("spec").([]interface{}))
+		if err != nil {
+			return err
+		}
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: spec,
+		})
+	}
+	log.Printf("[INFO] Updating custom resource definition %q: %s", name, redacted(ops))
+	var out []byte
+	err := sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.Discovery().RESTClient().Patch(pkgApi.JSONPatchType).
+			AbsPath(customResourceDefinitionsPath, name).
+			Body(data).
+			DoRaw()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update custom resource definition: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated custom resource definition: %s", redactedJSON(out))
+
+	return resourceKubernetesCustomResourceDefinitionRead(d, meta)
+}
+
+func resourceKubernetesCustomResourceDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting custom resource definition: %q", name)
+	_, err := conn.Discovery().RESTClient().Delete().
+		AbsPath(customResourceDefinitionsPath, name).
+		DoRaw()
+	if err != nil {
+		return err
+	}
+
+	// The custom resources are removed before the definition, its names
+	// can't be reused until then
+	err = waitForDeletion("CustomResourceDefinition", name, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := getCustomResourceDefinition(conn, name)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Custom resource definition %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesCustomResourceDefinitionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Checking custom resource definition %s", name)
+	_, err := getCustomResourceDefinition(conn, name)
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+	}
+	return true, err
+}
+
+func getCustomResourceDefinition(conn *kubernetes.Clientset, name string) (*customResourceDefinition, error) {
+	out, err := conn.Discovery().RESTClient().Get().
+		AbsPath(customResourceDefinitionsPath, name).
+		DoRaw()
+	if err != nil {
+		return nil, err
+	}
+	crd := &customResourceDefinition{}
+	err = json.Unmarshal(out, crd)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode custom resource definition %s: %s", name, err)
+	}
+	return crd, nil
+}
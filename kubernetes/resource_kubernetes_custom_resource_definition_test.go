@@ -0,0 +1,176 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccKubernetesCustomResourceDefinition_basic(t *testing.T) {
+	var conf customResourceDefinition
+	kind := "TfAccTest" + strings.Title(acctest.RandStringFromCharSet(10, "abcdefghijklmnopqrstuvwxyz"))
+	plural := strings.ToLower(kind) + "s"
+	name := plural + ".tf-acc-test.example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_custom_resource_definition.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesCustomResourceDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCustomResourceDefinitionConfig_basic(kind, plural),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceDefinitionExists("kubernetes_custom_resource_definition.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_custom_resource_definition.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_custom_resource_definition.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.group", "tf-acc-test.example.com"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.version", "v1"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.scope", "Namespaced"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.names.0.plural", plural),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.names.0.kind", kind),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.names.0.singular", strings.ToLower(kind)),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.names.0.list_kind", kind+"List"),
+					func(s *terraform.State) error {
+						if state, _ := customResourceDefinitionState(&conf); state != "Established" {
+							return fmt.Errorf("Expected the custom resource definition to be established, got %s", state)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccKubernetesCustomResourceDefinitionConfig_modified(kind, plural),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceDefinitionExists("kubernetes_custom_resource_definition.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.names.0.short_names.#", "1"),
+					resource.TestCheckResourceAttrSet("kubernetes_custom_resource_definition.test", "spec.0.validation"),
+					resource.TestCheckResourceAttr("kubernetes_manifest.test", "id", "apiVersion=tf-acc-test.example.com/v1,kind="+kind+",namespace=default,name=example"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesCustomResourceDefinition_importBasic(t *testing.T) {
+	resourceName := "kubernetes_custom_resource_definition.test"
+	kind := "TfAccTest" + strings.Title(acctest.RandStringFromCharSet(10, "abcdefghijklmnopqrstuvwxyz"))
+	plural := strings.ToLower(kind) + "s"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesCustomResourceDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCustomResourceDefinitionConfig_basic(kind, plural),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesCustomResourceDefinitionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*providerMeta).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_custom_resource_definition" {
+			continue
+		}
+		resp, err := getCustomResourceDefinition(conn, rs.Primary.ID)
+		if err == nil {
+			// Definitions are only removed once all custom resources are gone
+			if resp.Name == rs.Primary.ID && resp.DeletionTimestamp == nil {
+				return fmt.Errorf("Custom resource definition still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesCustomResourceDefinitionExists(n string, obj *customResourceDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*providerMeta).conn
+		out, err := getCustomResourceDefinition(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesCustomResourceDefinitionConfig_basic(kind, plural string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_custom_resource_definition" "test" {
+  metadata {
+    name = "%s.tf-acc-test.example.com"
+  }
+  spec {
+    group   = "tf-acc-test.example.com"
+    version = "v1"
+    names {
+      plural = "%s"
+      kind   = "%s"
+    }
+  }
+}
+`, plural, plural, kind)
+}
+
+func testAccKubernetesCustomResourceDefinitionConfig_modified(kind, plural string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_custom_resource_definition" "test" {
+  metadata {
+    name = "%s.tf-acc-test.example.com"
+  }
+  spec {
+    group   = "tf-acc-test.example.com"
+    version = "v1"
+    names {
+      plural      = "%s"
+      kind        = "%s"
+      short_names = ["tfacc"]
+    }
+    validation = <<EOF
+properties:
+  spec:
+    required: ["replicas"]
+    properties:
+      replicas:
+        type: integer
+        minimum: 1
+EOF
+  }
+}
+
+resource "kubernetes_manifest" "test" {
+  manifest = <<EOF
+apiVersion: tf-acc-test.example.com/v1
+kind: %s
+metadata:
+  name: example
+spec:
+  replicas: 2
+EOF
+
+  depends_on = ["kubernetes_custom_resource_definition.test"]
+}
+`, plural, plural, kind, kind)
+}
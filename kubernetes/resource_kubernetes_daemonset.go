@@ -3,14 +3,13 @@ package kubernetes
 import (
 	"fmt"
 	"log"
-	"encoding/json"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	v1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	v1 "k8s.io/api/apps/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesDaemonSet() *schema.Resource {
@@ -24,44 +23,63 @@ func resourceKubernetesDaemonSet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("Daemonset", false),
+			"metadata":                  namespacedMetadataSchema("Daemonset", false),
+			"delete_propagation_policy": deletePropagationPolicySchema("daemon set"),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec of the pod owned by the cluster",
 				Required:    true,
-				ForceNew: true,
 				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: daemonsetSpecFields(),
 				},
 			},
+			"wait_for_rollout": {
+				Type:        schema.TypeBool,
+				Description: "Wait for the rollout of the daemonset to complete. Defaults to true.",
+				Optional:    true,
+				Default:     true,
+			},
 		},
 	}
 }
 
 func resourceKubernetesDaemonsetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	daemonset := &v1.DaemonSet{
 		ObjectMeta: metadata,
-		Spec: expandDaemonsetSpec(d.Get("spec").([]interface{})),
+		Spec:       expandDaemonsetSpec(d.Get("spec").([]interface{}), d, meta.(*providerMeta).metadata),
 	}
-	log.Printf("[INFO] Creating new Daemonset: %#v", daemonset)
+	log.Printf("[INFO] Creating new Daemonset: %s", redacted(daemonset))
 	daemonset, err := conn.AppsV1().DaemonSets(metadata.Namespace).Create(daemonset)
 
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new Daemonset: %#v", daemonset)
+	log.Printf("[INFO] Submitted new Daemonset: %s", redacted(daemonset))
 	d.SetId(buildId(daemonset.ObjectMeta))
 
+	if d.Get("wait_for_rollout").(bool) {
+		err = waitForRollout(conn, "DaemonSet", daemonset.ObjectMeta, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceKubernetesDaemonsetRead(d, meta)
 }
 
 func resourceKubernetesDaemonsetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -71,18 +89,18 @@ func resourceKubernetesDaemonsetRead(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] Reading Daemonset %s", name)
 	daemonset, err := conn.AppsV1().DaemonSets(namespace).Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
 
-	log.Printf("[INFO] Received Daemonset: %#v", daemonset)
-	err = d.Set("metadata", flattenMetadata(daemonset.ObjectMeta))
+	log.Printf("[INFO] Received Daemonset: %s", redacted(daemonset))
+	err = d.Set("metadata", flattenMetadata(daemonset.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
 
-	flattenedSpec := flattenDaemonsetSpec(daemonset.Spec)
-	log.Printf("[DEBUG] Flattened Daemonset spec: %#v", flattenedSpec)
+	flattenedSpec := flattenDaemonsetSpec(daemonset.Spec, d, meta.(*providerMeta).metadata)
+	log.Printf("[DEBUG] Flattened Daemonset spec: %s", redacted(flattenedSpec))
 	err = d.Set("spec", flattenedSpec)
 	if err != nil {
 		return err
@@ -92,42 +110,73 @@ func resourceKubernetesDaemonsetRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesDaemonsetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
-	
-		namespace, name, err := idParts(d.Id())
-		if err != nil {
-			return err
-		}
-	
-		metadata := expandMetadata(d.Get("metadata").([]interface{}))
-		daemonset := &v1.DaemonSet{
-			ObjectMeta: metadata,
-			Spec: expandDaemonsetSpec(d.Get("spec").([]interface{})),
-		}
+	conn := meta.(*providerMeta).conn
+	config := meta.(*providerMeta).metadata
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
 
-		data, err := json.Marshal(daemonset)
+	// The metadata is patched key by key, leaving the ignored keys alone
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	if len(ops) > 0 {
+		log.Printf("[INFO] Updating metadata of Daemonset %s: %s", d.Id(), redacted(ops))
+		err = sendJSONPatch(ops, func(data []byte) (err error) {
+			_, err = conn.AppsV1().DaemonSets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+			return err
+		})
 		if err != nil {
-			return fmt.Errorf("Failed to marshal update operations: %s", err)
+			return fmt.Errorf("Failed to update Daemonset metadata: %s", err)
 		}
-	
-		log.Printf("[INFO] Updating Daemonset %s: %s", d.Id(), daemonset)
-	
-		out, err := conn.AppsV1().DaemonSets(namespace).Patch(name, pkgApi.StrategicMergePatchType, data)
+	}
+
+	live, err := conn.AppsV1().DaemonSets(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	spec := expandDaemonsetSpec(d.Get("spec").([]interface{}), d, config)
+	keepIgnoredMetadata(&spec.Template.ObjectMeta, live.Spec.Template.ObjectMeta, config)
+
+	// The template is replaced as a whole so that removed fields (e.g. env vars) don't linger
+	data, err := strategicMergePatchReplacing(map[string]interface{}{"spec": spec}, "spec.template")
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating Daemonset %s: %s", d.Id(), redactedJSON(data))
+
+	out, err := conn.AppsV1().DaemonSets(namespace).Patch(name, pkgApi.StrategicMergePatchType, data)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted updated Daemonset: %s", redacted(out))
+	d.SetId(buildId(out.ObjectMeta))
+
+	if d.Get("wait_for_rollout").(bool) {
+		err = waitForRollout(conn, "DaemonSet", out.ObjectMeta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
-		log.Printf("[INFO] Submitted updated Daemonset: %#v", out)
-	
-		d.SetId(buildId(out.ObjectMeta))
-		return resourceKubernetesDaemonsetRead(d, meta)
+	}
+
+	return resourceKubernetesDaemonsetRead(d, meta)
 }
 
 func resourceKubernetesDaemonsetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
-	log.Printf("[INFO] Deleting Daemonset: %#v", name)
-	err = conn.AppsV1().DaemonSets(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	log.Printf("[INFO] Deleting Daemonset: %q", name)
+	err = conn.AppsV1().DaemonSets(namespace).Delete(name, expandDeleteOptions(d))
+	if err != nil {
+		return err
+	}
+
+	err = waitForDeletion("DaemonSet", name, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := conn.AppsV1().DaemonSets(namespace).Get(name, meta_v1.GetOptions{})
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -138,7 +187,7 @@ func resourceKubernetesDaemonsetDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesDaemonsetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	log.Printf("[INFO] Checking Daemonset %s", name)
@@ -147,7 +196,7 @@ func resourceKubernetesDaemonsetExists(d *schema.ResourceData, meta interface{})
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
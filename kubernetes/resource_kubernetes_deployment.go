@@ -4,15 +4,12 @@ import (
 	"fmt"
 	"log"
 	"time"
-	"encoding/json"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/hashicorp/terraform/helper/resource"
+	v1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	v1 "k8s.io/api/apps/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesDeployment() *schema.Resource {
@@ -26,75 +23,63 @@ func resourceKubernetesDeployment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("Deployment", false),
+			"metadata":                  namespacedMetadataSchema("Deployment", false),
+			"delete_propagation_policy": deletePropagationPolicySchema("deployment"),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec of the pod owned by the cluster",
 				Required:    true,
-				ForceNew: true,
 				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: deploymentSpecFields(),
 				},
 			},
+			"wait_for_rollout": {
+				Type:        schema.TypeBool,
+				Description: "Wait for the rollout of the deployment to complete. Defaults to true.",
+				Optional:    true,
+				Default:     true,
+			},
 		},
 	}
 }
 
 func resourceKubernetesDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	Deployment := &v1.Deployment{
 		ObjectMeta: metadata,
-		Spec: expandDeploymentSpec(d.Get("spec").([]interface{})),
+		Spec:       expandDeploymentSpec(d.Get("spec").([]interface{}), d, meta.(*providerMeta).metadata),
 	}
-	log.Printf("[INFO] Creating new Deployment: %#v", Deployment)
+	log.Printf("[INFO] Creating new Deployment: %s", redacted(Deployment))
 	Deployment, err := conn.AppsV1().Deployments(metadata.Namespace).Create(Deployment)
 
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new Deployment: %#v", Deployment)
+	log.Printf("[INFO] Submitted new Deployment: %s", redacted(Deployment))
 	d.SetId(buildId(Deployment.ObjectMeta))
-	name := Deployment.ObjectMeta.Name
-
-	pending := make([]string, *Deployment.Spec.Replicas)
-	for i := range pending {
-		pending[i] = fmt.Sprintf("%v", i)
-	}
-
-	stateConf := &resource.StateChangeConf{
-		Target:  []string{fmt.Sprintf("%v", *Deployment.Spec.Replicas)},
-		Pending: pending,
-		Timeout: 20 * time.Minute,
-		Refresh: func() (interface{}, string, error) {
-			out, err := conn.AppsV1().Deployments(metadata.Namespace).Get(name, meta_v1.GetOptions{})
-			if err != nil {
-				log.Printf("[ERROR] Received error: %#v", err)
-				return out, "", err
-			}
-
-			statusPhase := fmt.Sprintf("%v", out.Status.ReadyReplicas)
-			log.Printf("[DEBUG] Deployment %s ready replicas: %#v", out.Name, statusPhase)
-			return out, statusPhase, nil
-		},
-	}
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		lastWarnings, wErr := getLastWarningsForObject(conn, Deployment.ObjectMeta, "Deployment", 3)
-		if wErr != nil {
-			return wErr
+
+	if d.Get("wait_for_rollout").(bool) {
+		err = waitForRollout(conn, "Deployment", Deployment.ObjectMeta, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
 		}
-		return fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
 	}
 
 	return resourceKubernetesDeploymentRead(d, meta)
 }
 
 func resourceKubernetesDeploymentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -104,18 +89,18 @@ func resourceKubernetesDeploymentRead(d *schema.ResourceData, meta interface{})
 	log.Printf("[INFO] Reading Deployment %s", name)
 	Deployment, err := conn.AppsV1().Deployments(namespace).Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
 
-	log.Printf("[INFO] Received Deployment: %#v", Deployment)
-	err = d.Set("metadata", flattenMetadata(Deployment.ObjectMeta))
+	log.Printf("[INFO] Received Deployment: %s", redacted(Deployment))
+	err = d.Set("metadata", flattenMetadata(Deployment.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
 
-	flattenedSpec := flattenDeploymentSpec(Deployment.Spec)
-	log.Printf("[DEBUG] Flattened Deployment spec: %#v", flattenedSpec)
+	flattenedSpec := flattenDeploymentSpec(Deployment.Spec, d, meta.(*providerMeta).metadata)
+	log.Printf("[DEBUG] Flattened Deployment spec: %s", redacted(flattenedSpec))
 	err = d.Set("spec", flattenedSpec)
 	if err != nil {
 		return err
@@ -125,42 +110,73 @@ func resourceKubernetesDeploymentRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
-	
-		namespace, name, err := idParts(d.Id())
-		if err != nil {
-			return err
-		}
+	conn := meta.(*providerMeta).conn
+	config := meta.(*providerMeta).metadata
 
-		metadata := expandMetadata(d.Get("metadata").([]interface{}))
-		Deployment := &v1.Deployment{
-			ObjectMeta: metadata,
-			Spec: expandDeploymentSpec(d.Get("spec").([]interface{})),
-		}
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
 
-		data, err := json.Marshal(Deployment)
+	// The metadata is patched key by key, leaving the ignored keys alone
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	if len(ops) > 0 {
+		log.Printf("[INFO] Updating metadata of Deployment %s: %s", d.Id(), redacted(ops))
+		err = sendJSONPatch(ops, func(data []byte) (err error) {
+			_, err = conn.AppsV1().Deployments(namespace).Patch(name, pkgApi.JSONPatchType, data)
+			return err
+		})
 		if err != nil {
-			return fmt.Errorf("Failed to marshal update operations: %s", err)
+			return fmt.Errorf("Failed to update Deployment metadata: %s", err)
 		}
-	
-		log.Printf("[INFO] Updating Deployment %s: %s", d.Id(), Deployment)
-	
-		out, err := conn.AppsV1().Deployments(namespace).Patch(name, pkgApi.StrategicMergePatchType, data)
+	}
+
+	live, err := conn.AppsV1().Deployments(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	spec := expandDeploymentSpec(d.Get("spec").([]interface{}), d, config)
+	keepIgnoredMetadata(&spec.Template.ObjectMeta, live.Spec.Template.ObjectMeta, config)
+
+	// The template is replaced as a whole so that removed fields (e.g. env vars) don't linger
+	data, err := strategicMergePatchReplacing(map[string]interface{}{"spec": spec}, "spec.template")
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating Deployment %s: %s", d.Id(), redactedJSON(data))
+
+	out, err := conn.AppsV1().Deployments(namespace).Patch(name, pkgApi.StrategicMergePatchType, data)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted updated Deployment: %s", redacted(out))
+	d.SetId(buildId(out.ObjectMeta))
+
+	if d.Get("wait_for_rollout").(bool) {
+		err = waitForRollout(conn, "Deployment", out.ObjectMeta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
-		log.Printf("[INFO] Submitted updated Deployment: %#v", out)
-	
-		d.SetId(buildId(out.ObjectMeta))
-		return resourceKubernetesDeploymentRead(d, meta)
+	}
+
+	return resourceKubernetesDeploymentRead(d, meta)
 }
 
 func resourceKubernetesDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
-	log.Printf("[INFO] Deleting Deployment: %#v", name)
-	err = conn.AppsV1().Deployments(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	log.Printf("[INFO] Deleting Deployment: %q", name)
+	err = conn.AppsV1().Deployments(namespace).Delete(name, expandDeleteOptions(d))
+	if err != nil {
+		return err
+	}
+
+	err = waitForDeletion("Deployment", name, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := conn.AppsV1().Deployments(namespace).Get(name, meta_v1.GetOptions{})
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -171,7 +187,7 @@ func resourceKubernetesDeploymentDelete(d *schema.ResourceData, meta interface{}
 }
 
 func resourceKubernetesDeploymentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	log.Printf("[INFO] Checking Deployment %s", name)
@@ -180,7 +196,7 @@ func resourceKubernetesDeploymentExists(d *schema.ResourceData, meta interface{}
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
@@ -0,0 +1,310 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	api "k8s.io/api/apps/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestAccKubernetesDeployment_updateInPlace(t *testing.T) {
+	var conf api.Deployment
+	var uid types.UID
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_deployment.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentConfig_basic(name, "nginx:1.14", "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists("kubernetes_deployment.test", &conf),
+					func(s *terraform.State) error {
+						uid = conf.UID
+						return nil
+					},
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.replicas", "1"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.container.0.image", "nginx:1.14"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.container.0.env.#", "2"),
+				),
+			},
+			{
+				Config: testAccKubernetesDeploymentConfig_modified(name, "nginx:1.15"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists("kubernetes_deployment.test", &conf),
+					func(s *terraform.State) error {
+						if conf.UID != uid {
+							return fmt.Errorf("Expected the deployment to be updated in place, UID changed from %s to %s", uid, conf.UID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.replicas", "2"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.container.0.image", "nginx:1.15"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.container.0.env.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.container.0.env.0.name", "ONE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDeployment_withoutWaitForRollout(t *testing.T) {
+	var conf api.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_deployment.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The image doesn't exist, so the rollout would never complete
+				Config: testAccKubernetesDeploymentConfig_withoutWaitForRollout(name, "nginx:tf-acc-test-missing"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists("kubernetes_deployment.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "wait_for_rollout", "false"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.container.0.image", "nginx:tf-acc-test-missing"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDeployment_foregroundDeletion(t *testing.T) {
+	var conf api.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_deployment.test",
+		Providers:     testAccProviders,
+		// The deletion waits for the deployment and its pods to be gone
+		CheckDestroy: testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentConfig_deletePropagationPolicy(name, "Foreground"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists("kubernetes_deployment.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "delete_propagation_policy", "Foreground"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDeployment_importBasic(t *testing.T) {
+	resourceName := "kubernetes_deployment.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentConfig_basic(name, "nginx:1.14", "first"),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_rollout"},
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesDeploymentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*providerMeta).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_deployment" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.AppsV1().Deployments(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Namespace == namespace && resp.Name == name {
+				return fmt.Errorf("Deployment still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesDeploymentExists(n string, obj *api.Deployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*providerMeta).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.AppsV1().Deployments(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesDeploymentConfig_basic(name, image, envValue string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_deployment" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    replicas = 1
+    selector {
+      match_labels {
+        app = "tf-acc-test"
+      }
+    }
+    template {
+      metadata {
+        labels {
+          app = "tf-acc-test"
+        }
+      }
+      spec {
+        container {
+          name  = "web"
+          image = "%s"
+          env {
+            name  = "ONE"
+            value = "%s"
+          }
+          env {
+            name  = "TWO"
+            value = "second"
+          }
+        }
+      }
+    }
+  }
+}
+`, name, image, envValue)
+}
+
+func testAccKubernetesDeploymentConfig_modified(name, image string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_deployment" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    replicas = 2
+    selector {
+      match_labels {
+        app = "tf-acc-test"
+      }
+    }
+    template {
+      metadata {
+        labels {
+          app = "tf-acc-test"
+        }
+      }
+      spec {
+        container {
+          name  = "web"
+          image = "%s"
+          env {
+            name  = "ONE"
+            value = "first"
+          }
+        }
+      }
+    }
+  }
+}
+`, name, image)
+}
+
+func testAccKubernetesDeploymentConfig_withoutWaitForRollout(name, image string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_deployment" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    replicas = 1
+    selector {
+      match_labels {
+        app = "tf-acc-test"
+      }
+    }
+    template {
+      metadata {
+        labels {
+          app = "tf-acc-test"
+        }
+      }
+      spec {
+        container {
+          name  = "web"
+          image = "%s"
+        }
+      }
+    }
+  }
+  wait_for_rollout = false
+}
+`, name, image)
+}
+
+func testAccKubernetesDeploymentConfig_deletePropagationPolicy(name, policy string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_deployment" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    replicas = 1
+    selector {
+      match_labels {
+        app = "tf-acc-test"
+      }
+    }
+    template {
+      metadata {
+        labels {
+          app = "tf-acc-test"
+        }
+      }
+      spec {
+        container {
+          name  = "web"
+          image = "nginx:1.15"
+        }
+      }
+    }
+  }
+  delete_propagation_policy = "%s"
+}
+`, name, policy)
+}
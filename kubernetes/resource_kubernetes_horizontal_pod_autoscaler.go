@@ -9,7 +9,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesHorizontalPodAutoscaler() *schema.Resource {
@@ -82,27 +81,27 @@ func resourceKubernetesHorizontalPodAutoscaler() *schema.Resource {
 }
 
 func resourceKubernetesHorizontalPodAutoscalerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	svc := api.HorizontalPodAutoscaler{
 		ObjectMeta: metadata,
 		Spec:       expandHorizontalPodAutoscalerSpec(d.Get("spec").([]interface{})),
 	}
-	log.Printf("[INFO] Creating new horizontal pod autoscaler: %#v", svc)
+	log.Printf("[INFO] Creating new horizontal pod autoscaler: %s", redacted(svc))
 	out, err := conn.AutoscalingV1().HorizontalPodAutoscalers(metadata.Namespace).Create(&svc)
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Submitted new horizontal pod autoscaler: %#v", out)
+	log.Printf("[INFO] Submitted new horizontal pod autoscaler: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesHorizontalPodAutoscalerRead(d, meta)
 }
 
 func resourceKubernetesHorizontalPodAutoscalerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -111,17 +110,17 @@ func resourceKubernetesHorizontalPodAutoscalerRead(d *schema.ResourceData, meta
 	log.Printf("[INFO] Reading horizontal pod autoscaler %s", name)
 	svc, err := conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received horizontal pod autoscaler: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta))
+	log.Printf("[INFO] Received horizontal pod autoscaler: %s", redacted(svc))
+	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
 
 	flattened := flattenHorizontalPodAutoscalerSpec(svc.Spec)
-	log.Printf("[DEBUG] Flattened horizontal pod autoscaler spec: %#v", flattened)
+	log.Printf("[DEBUG] Flattened horizontal pod autoscaler spec: %s", redacted(flattened))
 	err = d.Set("spec", flattened)
 	if err != nil {
 		return err
@@ -131,41 +130,41 @@ func resourceKubernetesHorizontalPodAutoscalerRead(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesHorizontalPodAutoscalerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		diffOps := patchHorizontalPodAutoscalerSpec("spec.0.", "/spec", d)
 		ops = append(ops, diffOps...)
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating horizontal pod autoscaler %q: %v", name, string(data))
-	out, err := conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating horizontal pod autoscaler %q: %s", name, redacted(ops))
+	var out *api.HorizontalPodAutoscaler
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update horizontal pod autoscaler: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated horizontal pod autoscaler: %#v", out)
+	log.Printf("[INFO] Submitted updated horizontal pod autoscaler: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesHorizontalPodAutoscalerRead(d, meta)
 }
 
 func resourceKubernetesHorizontalPodAutoscalerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Deleting horizontal pod autoscaler: %#v", name)
+	log.Printf("[INFO] Deleting horizontal pod autoscaler: %q", name)
 	err = conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Delete(name, &meta_v1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -178,7 +177,7 @@ func resourceKubernetesHorizontalPodAutoscalerDelete(d *schema.ResourceData, met
 }
 
 func resourceKubernetesHorizontalPodAutoscalerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -191,7 +190,7 @@ func resourceKubernetesHorizontalPodAutoscalerExists(d *schema.ResourceData, met
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
@@ -3,14 +3,12 @@ package kubernetes
 import (
 	"fmt"
 	"log"
-	"encoding/json"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	api "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -25,85 +23,83 @@ func resourceKubernetesIngress() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("Ingress", true),
 			"spec": {
-				Type:        schema.TypeList,
-				Required:    true,
-				ForceNew: true,
-				MaxItems:    1,
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"backend": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							ForceNew: true,
-							MaxItems:    1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
 									"service_name": {
-										Type:        schema.TypeString,
-										Required:    true,
+										Type:     schema.TypeString,
+										Required: true,
 									},
 									"service_port": {
-										Type:        schema.TypeString,
-										Required:    true,
+										Type:     schema.TypeString,
+										Required: true,
 									},
 								},
 							},
 						},
 						"tls": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							ForceNew: true,
+							Type:     schema.TypeList,
+							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"hosts": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										Elem:        &schema.Schema{Type: schema.TypeString},
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
 									},
 									"secret_name": {
-										Type:        schema.TypeString,
-										Optional:    true,
+										Type:     schema.TypeString,
+										Optional: true,
 									},
 								},
 							},
 						},
 						"rules": {
-							Type:        schema.TypeList,
-							Optional:    true,
+							Type:     schema.TypeList,
+							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-								"host": {
-									Type:        schema.TypeString,
-									Optional:    true,
-								},
-								"http": {
-									Type:        schema.TypeList,
-									Optional:    true,
-									MaxItems:    1,
-									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"paths": {
-												Type:        schema.TypeList,
-												Required:    true,
-												Elem: &schema.Resource{
-													Schema: map[string]*schema.Schema{
-														"path": {
-															Type:        schema.TypeString,
-															Required:    true,
-														},
-														"backend": {
-															Type:        schema.TypeList,
-															Required:    true,
-															MaxItems:    1,
-															Elem: &schema.Resource{
-																Schema: map[string]*schema.Schema{
-																	"service_name": {
-																		Type:        schema.TypeString,
-																		Required:    true,
-																	},
-																	"service_port": {
-																		Type:        schema.TypeString,
-																		Required:    true,
+									"host": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"http": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"paths": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"path": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"backend": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"service_name": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																		"service_port": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
 																	},
 																},
 															},
@@ -118,33 +114,32 @@ func resourceKubernetesIngress() *schema.Resource {
 						},
 					},
 				},
-				},
 			},
 		},
 	}
 }
 
 func resourceKubernetesIngressCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	ingress := api.Ingress{
 		ObjectMeta: metadata,
 		Spec:       expandIngressSpec(d.Get("spec").([]interface{})),
 	}
-	log.Printf("[INFO] Creating new ingress: %#v", ingress)
+	log.Printf("[INFO] Creating new ingress: %s", redacted(ingress))
 	out, err := conn.ExtensionsV1beta1().Ingresses(metadata.Namespace).Create(&ingress)
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new ingress: %#v", out)
+	log.Printf("[INFO] Submitted new ingress: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesIngressRead(d, meta)
 }
 
 func resourceKubernetesIngressRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -154,17 +149,17 @@ func resourceKubernetesIngressRead(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[INFO] Reading ingress %s", name)
 	ingress, err := conn.ExtensionsV1beta1().Ingresses(namespace).Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received ingress: %#v", ingress)
-	err = d.Set("metadata", flattenMetadata(ingress.ObjectMeta))
+	log.Printf("[INFO] Received ingress: %s", redacted(ingress))
+	err = d.Set("metadata", flattenMetadata(ingress.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
 
 	flattened := flattenIngressSpec(ingress.Spec)
-	log.Printf("[DEBUG] Flattened ingress spec: %#v", flattened)
+	log.Printf("[DEBUG] Flattened ingress spec: %s", redacted(flattened))
 	err = d.Set("spec", flattened)
 	if err != nil {
 		return err
@@ -174,31 +169,41 @@ func resourceKubernetesIngressRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceKubernetesIngressUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	ingress := api.Ingress{
-		ObjectMeta: metadata,
-		Spec:       expandIngressSpec(d.Get("spec").([]interface{})),
+	// The metadata is patched key by key, leaving the ignored keys alone
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	if len(ops) > 0 {
+		log.Printf("[INFO] Updating metadata of Ingress %s: %s", d.Id(), redacted(ops))
+		err = sendJSONPatch(ops, func(data []byte) (err error) {
+			_, err = conn.ExtensionsV1beta1().Ingresses(namespace).Patch(name, pkgApi.JSONPatchType, data)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to update Ingress metadata: %s", err)
+		}
 	}
 
-	data, err := json.Marshal(ingress)
+	spec := expandIngressSpec(d.Get("spec").([]interface{}))
+
+	// The spec is replaced as a whole so that removed rules and backends don't linger
+	data, err := strategicMergePatchReplacing(map[string]interface{}{"spec": spec}, "spec")
 	if err != nil {
 		return fmt.Errorf("Failed to marshal update operations: %s", err)
 	}
 
-	log.Printf("[INFO] Updating Ingress %s: %s", d.Id(), ingress)
+	log.Printf("[INFO] Updating Ingress %s: %s", d.Id(), redactedJSON(data))
 
 	out, err := conn.ExtensionsV1beta1().Ingresses(namespace).Patch(name, pkgApi.StrategicMergePatchType, data)
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted updated Ingress: %#v", out)
+	log.Printf("[INFO] Submitted updated Ingress: %s", redacted(out))
 
 	d.SetId(buildId(out.ObjectMeta))
 
@@ -206,14 +211,14 @@ func resourceKubernetesIngressUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesIngressDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Deleting ingress: %#v", name)
+	log.Printf("[INFO] Deleting ingress: %q", name)
 	err = conn.ExtensionsV1beta1().Ingresses(namespace).Delete(name, &meta_v1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -226,7 +231,7 @@ func resourceKubernetesIngressDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesIngressExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -239,7 +244,7 @@ func resourceKubernetesIngressExists(d *schema.ResourceData, meta interface{}) (
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
@@ -265,7 +270,7 @@ func expandIngressSpec(in []interface{}) api.IngressSpec {
 		for i, n := range v {
 			tls := n.(map[string]interface{})
 			obj[i] = api.IngressTLS{
-				Hosts: sliceOfString(tls["hosts"].([]interface {})),
+				Hosts:      sliceOfString(tls["hosts"].([]interface{})),
 				SecretName: tls["secret_name"].(string),
 			}
 		}
@@ -286,7 +291,7 @@ func expandIngressSpec(in []interface{}) api.IngressSpec {
 				if len(w) > 0 {
 					http := api.HTTPIngressRuleValue{}
 
-					x := w[0].(map[string]interface{})["paths"].([]interface{});
+					x := w[0].(map[string]interface{})["paths"].([]interface{})
 					paths := make([]api.HTTPIngressPath, len(x), len(x))
 					for i, o := range x {
 						path := o.(map[string]interface{})
@@ -295,7 +300,7 @@ func expandIngressSpec(in []interface{}) api.IngressSpec {
 						}
 
 						backend := (path["backend"].([]interface{}))[0].(map[string]interface{})
-						paths[i].Backend = api.IngressBackend {
+						paths[i].Backend = api.IngressBackend{
 							ServiceName: backend["service_name"].(string),
 							ServicePort: intstr.Parse(backend["service_port"].(string)),
 						}
@@ -318,9 +323,9 @@ func flattenIngressSpec(in api.IngressSpec) []interface{} {
 		a := make(map[string]interface{})
 		a["service_name"] = backend.ServiceName
 		a["service_port"] = backend.ServicePort.String()
-		att["backend"] = a
+		att["backend"] = []interface{}{a}
 	}
-	
+
 	if in.TLS != nil {
 		obj := make([]map[string]interface{}, len(in.TLS), len(in.TLS))
 		for i, n := range in.TLS {
@@ -337,6 +342,10 @@ func flattenIngressSpec(in api.IngressSpec) []interface{} {
 		for i, n := range in.Rules {
 			a := make(map[string]interface{})
 			a["host"] = n.Host
+			if n.HTTP == nil {
+				obj[i] = a
+				continue
+			}
 			http := *n.HTTP
 			p := make([]map[string]interface{}, len(http.Paths), len(http.Paths))
 			for j, o := range http.Paths {
@@ -363,4 +372,4 @@ func flattenIngressSpec(in api.IngressSpec) []interface{} {
 	}
 
 	return []interface{}{att}
-}
\ No newline at end of file
+}
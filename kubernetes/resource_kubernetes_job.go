@@ -0,0 +1,262 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	batchv1 "k8s.io/api/batch/v1"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	kubernetes "k8s.io/client-go/kubernetes"
+)
+
+func resourceKubernetesJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesJobCreate,
+		Read:   resourceKubernetesJobRead,
+		Exists: resourceKubernetesJobExists,
+		Update: resourceKubernetesJobUpdate,
+		Delete: resourceKubernetesJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: resourceKubernetesJobSchema(),
+	}
+}
+
+func resourceKubernetesJobSchema() map[string]*schema.Schema {
+	s := jobTemplateSpecFields()
+	s["metadata"] = namespacedMetadataSchema("job", true)
+	s["delete_propagation_policy"] = deletePropagationPolicySchema("job")
+	s["spec"].Description = "Spec of the job owned by the cluster"
+	s["spec"].ForceNew = true
+	s["wait_for_completion"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Wait for the job to reach the Complete or Failed state before returning. Defaults to false.",
+		Optional:    true,
+		Default:     false,
+	}
+	return s
+}
+
+func resourceKubernetesJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
+	job := batchv1.Job{
+		ObjectMeta: metadata,
+		Spec:       expandJobSpec(d.Get("spec").([]interface{}), d, meta.(*providerMeta).metadata, "spec.0."),
+	}
+	log.Printf("[INFO] Creating new job: %s", redacted(job))
+	out, err := conn.BatchV1().Jobs(metadata.Namespace).Create(&job)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new job: %s", redacted(out))
+	d.SetId(buildId(out.ObjectMeta))
+
+	if d.Get("wait_for_completion").(bool) {
+		err = waitForJobCompletion(conn, out.ObjectMeta, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Job %s completed", out.Name)
+	}
+
+	return resourceKubernetesJobRead(d, meta)
+}
+
+func resourceKubernetesJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading job %s", name)
+	job, err := conn.BatchV1().Jobs(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+		return err
+	}
+	log.Printf("[INFO] Received job: %s", redacted(job))
+
+	_, hasLabels := d.GetOk("metadata.0.labels")
+	removeGeneratedJobLabels(job, hasLabels)
+	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d, meta.(*providerMeta).metadata))
+	if err != nil {
+		return err
+	}
+
+	flattenedSpec := flattenJobSpec(job.Spec, d, meta.(*providerMeta).metadata, "spec.0.")
+	log.Printf("[DEBUG] Flattened job spec: %s", redacted(flattenedSpec))
+	err = d.Set("spec", flattenedSpec)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	log.Printf("[INFO] Updating job %s: %s", d.Id(), redacted(ops))
+
+	var out *batchv1.Job
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.BatchV1().Jobs(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted updated job: %s", redacted(out))
+
+	d.SetId(buildId(out.ObjectMeta))
+	return resourceKubernetesJobRead(d, meta)
+}
+
+func resourceKubernetesJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting job: %q", name)
+	opts := expandDeleteOptions(d)
+	if opts.PropagationPolicy == nil {
+		// The API orphans the pods of a batch/v1 Job by default
+		propagation := meta_v1.DeletePropagationBackground
+		opts.PropagationPolicy = &propagation
+	}
+	err = conn.BatchV1().Jobs(namespace).Delete(name, opts)
+	if err != nil {
+		return err
+	}
+
+	err = waitForDeletion("Job", name, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := conn.BatchV1().Jobs(namespace).Get(name, meta_v1.GetOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Job %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesJobExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*providerMeta).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking job %s", name)
+	_, err = conn.BatchV1().Jobs(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+	}
+	return true, err
+}
+
+func waitForJobCompletion(conn *kubernetes.Clientset, metadata meta_v1.ObjectMeta, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Target:  []string{string(batchv1.JobComplete)},
+		Pending: []string{"Running"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			out, err := conn.BatchV1().Jobs(metadata.Namespace).Get(metadata.Name, meta_v1.GetOptions{})
+			if err != nil {
+				log.Printf("[ERROR] Received error: %s", redacted(err))
+				return out, "Error", err
+			}
+
+			state := jobState(out.Status)
+			log.Printf("[DEBUG] Job %s state: %s (active: %d, succeeded: %d, failed: %d)",
+				out.Name, state, out.Status.Active, out.Status.Succeeded, out.Status.Failed)
+			return out, state, nil
+		},
+	}
+	_, err := stateConf.WaitForState()
+	if err == nil {
+		return nil
+	}
+
+	lastWarnings, wErr := getLastWarningsForObject(conn, metadata, "Job", 3)
+	if wErr != nil {
+		return wErr
+	}
+	pods, wErr := conn.CoreV1().Pods(metadata.Namespace).List(meta_v1.ListOptions{
+		LabelSelector: fmt.Sprintf("job-name=%s", metadata.Name),
+	})
+	if wErr != nil {
+		return wErr
+	}
+	for _, pod := range pods.Items {
+		podWarnings, wErr := getLastWarningsForObject(conn, pod.ObjectMeta, "Pod", 3)
+		if wErr != nil {
+			return wErr
+		}
+		lastWarnings = append(lastWarnings, podWarnings...)
+	}
+	return fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+}
+
+func jobState(status batchv1.JobStatus) string {
+	for _, c := range status.Conditions {
+		if c.Status != api.ConditionTrue {
+			continue
+		}
+		if c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed {
+			return string(c.Type)
+		}
+	}
+	return "Running"
+}
+
+// removeGeneratedJobLabels drops the selector and labels which the Job
+// controller generates when the job isn't using a manual selector. The API
+// copies the labels of the template to a job created without labels, they
+// are dropped as well unless the job is configured with labels.
+func removeGeneratedJobLabels(job *batchv1.Job, hasLabels bool) {
+	if !hasLabels && reflect.DeepEqual(job.ObjectMeta.Labels, job.Spec.Template.ObjectMeta.Labels) {
+		job.ObjectMeta.Labels = nil
+	}
+	if job.Spec.ManualSelector != nil && *job.Spec.ManualSelector {
+		return
+	}
+	job.Spec.Selector = nil
+	for _, labels := range []map[string]string{job.ObjectMeta.Labels, job.Spec.Template.ObjectMeta.Labels} {
+		delete(labels, "controller-uid")
+		delete(labels, "job-name")
+	}
+}
@@ -0,0 +1,296 @@
+package kubernetes
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	batchv1 "k8s.io/api/batch/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRemoveGeneratedJobLabels(t *testing.T) {
+	generated := func(labels map[string]string) map[string]string {
+		labels["controller-uid"] = "1234"
+		labels["job-name"] = "test"
+		return labels
+	}
+	cases := []struct {
+		Name             string
+		Labels           map[string]string
+		TemplateLabels   map[string]string
+		HasLabels        bool
+		ExpectedLabels   map[string]string
+		ExpectedTemplate map[string]string
+	}{
+		{
+			"labels copied from the template",
+			generated(map[string]string{"app": "test"}),
+			generated(map[string]string{"app": "test"}),
+			false,
+			nil,
+			map[string]string{"app": "test"},
+		},
+		{
+			"configured labels equal to the template",
+			generated(map[string]string{"app": "test"}),
+			generated(map[string]string{"app": "test"}),
+			true,
+			map[string]string{"app": "test"},
+			map[string]string{"app": "test"},
+		},
+		{
+			"labels of the job",
+			map[string]string{"team": "batch"},
+			generated(map[string]string{"app": "test"}),
+			true,
+			map[string]string{"team": "batch"},
+			map[string]string{"app": "test"},
+		},
+	}
+
+	for _, tc := range cases {
+		job := &batchv1.Job{
+			ObjectMeta: meta_v1.ObjectMeta{Labels: tc.Labels},
+			Spec: batchv1.JobSpec{
+				Selector: &meta_v1.LabelSelector{MatchLabels: map[string]string{"controller-uid": "1234"}},
+			},
+		}
+		job.Spec.Template.ObjectMeta.Labels = tc.TemplateLabels
+
+		removeGeneratedJobLabels(job, tc.HasLabels)
+		if job.Spec.Selector != nil {
+			t.Fatalf("Expected the generated selector to be removed on %s", tc.Name)
+		}
+		if !reflect.DeepEqual(job.ObjectMeta.Labels, tc.ExpectedLabels) {
+			t.Fatalf("Unexpected labels on %s.\nExpected: %#v\nGiven:    %#v", tc.Name, tc.ExpectedLabels, job.ObjectMeta.Labels)
+		}
+		if !reflect.DeepEqual(job.Spec.Template.ObjectMeta.Labels, tc.ExpectedTemplate) {
+			t.Fatalf("Unexpected template labels on %s.\nExpected: %#v\nGiven:    %#v", tc.Name, tc.ExpectedTemplate, job.Spec.Template.ObjectMeta.Labels)
+		}
+	}
+}
+
+func TestAccKubernetesJob_basic(t *testing.T) {
+	var conf batchv1.Job
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_job.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesJobConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobExists("kubernetes_job.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_job.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_job.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_job.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_job.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.backoff_limit", "2"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.template.0.spec.0.container.0.image", "busybox"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.template.0.spec.0.restart_policy", "Never"),
+				),
+			},
+			{
+				Config: testAccKubernetesJobConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobExists("kubernetes_job.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "metadata.0.annotations.%", "2"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "metadata.0.annotations.Different", "1234"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one", "Different": "1234"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesJob_waitForCompletion(t *testing.T) {
+	var conf batchv1.Job
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_job.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesJobConfig_waitForCompletion(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobExists("kubernetes_job.test", &conf),
+					testAccCheckKubernetesJobSucceeded(&conf),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "wait_for_completion", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesJob_importBasic(t *testing.T) {
+	resourceName := "kubernetes_job.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesJobConfig_basic(name),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesJobSucceeded(obj *batchv1.Job) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if obj.Status.Succeeded < 1 {
+			return fmt.Errorf("Expected job %s to have succeeded, status: %#v", obj.Name, obj.Status)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*providerMeta).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_job" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.BatchV1().Jobs(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Namespace == namespace && resp.Name == name {
+				return fmt.Errorf("Job still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesJobExists(n string, obj *batchv1.Job) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*providerMeta).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.BatchV1().Jobs(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesJobConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_job" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    name = "%s"
+  }
+  spec {
+    backoff_limit = 2
+    template {
+      metadata {}
+      spec {
+        container {
+          name    = "hello"
+          image   = "busybox"
+          command = ["sh", "-c", "echo hello"]
+        }
+        restart_policy = "Never"
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesJobConfig_modified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_job" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+      Different = "1234"
+    }
+    name = "%s"
+  }
+  spec {
+    backoff_limit = 2
+    template {
+      metadata {}
+      spec {
+        container {
+          name    = "hello"
+          image   = "busybox"
+          command = ["sh", "-c", "echo hello"]
+        }
+        restart_policy = "Never"
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesJobConfig_waitForCompletion(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_job" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    template {
+      metadata {}
+      spec {
+        container {
+          name    = "sleep"
+          image   = "busybox"
+          command = ["sh", "-c", "sleep 10"]
+        }
+        restart_policy = "Never"
+      }
+    }
+  }
+  wait_for_completion = true
+}
+`, name)
+}
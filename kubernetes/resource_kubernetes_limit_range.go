@@ -9,7 +9,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesLimitRange() *schema.Resource {
@@ -80,9 +79,9 @@ func resourceKubernetesLimitRange() *schema.Resource {
 }
 
 func resourceKubernetesLimitRangeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
 	if err != nil {
 		return err
@@ -91,19 +90,19 @@ func resourceKubernetesLimitRangeCreate(d *schema.ResourceData, meta interface{}
 		ObjectMeta: metadata,
 		Spec:       spec,
 	}
-	log.Printf("[INFO] Creating new limit range: %#v", limitRange)
+	log.Printf("[INFO] Creating new limit range: %s", redacted(limitRange))
 	out, err := conn.CoreV1().LimitRanges(metadata.Namespace).Create(&limitRange)
 	if err != nil {
 		return fmt.Errorf("Failed to create limit range: %s", err)
 	}
-	log.Printf("[INFO] Submitted new limit range: %#v", out)
+	log.Printf("[INFO] Submitted new limit range: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesLimitRangeRead(d, meta)
 }
 
 func resourceKubernetesLimitRangeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -112,12 +111,12 @@ func resourceKubernetesLimitRangeRead(d *schema.ResourceData, meta interface{})
 	log.Printf("[INFO] Reading limit range %s", name)
 	limitRange, err := conn.CoreV1().LimitRanges(namespace).Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received limit range: %#v", limitRange)
+	log.Printf("[INFO] Received limit range: %s", redacted(limitRange))
 
-	err = d.Set("metadata", flattenMetadata(limitRange.ObjectMeta))
+	err = d.Set("metadata", flattenMetadata(limitRange.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
@@ -130,14 +129,14 @@ func resourceKubernetesLimitRangeRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesLimitRangeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
 		if err != nil {
@@ -148,30 +147,30 @@ func resourceKubernetesLimitRangeUpdate(d *schema.ResourceData, meta interface{}
 			Value: spec,
 		})
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating limit range %q: %v", name, string(data))
-	out, err := conn.CoreV1().LimitRanges(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating limit range %q: %s", name, redacted(ops))
+	var out *api.LimitRange
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.CoreV1().LimitRanges(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update limit range: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated limit range: %#v", out)
+	log.Printf("[INFO] Submitted updated limit range: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesLimitRangeRead(d, meta)
 }
 
 func resourceKubernetesLimitRangeDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Deleting limit range: %#v", name)
+	log.Printf("[INFO] Deleting limit range: %q", name)
 	err = conn.CoreV1().LimitRanges(namespace).Delete(name, &meta_v1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -184,7 +183,7 @@ func resourceKubernetesLimitRangeDelete(d *schema.ResourceData, meta interface{}
 }
 
 func resourceKubernetesLimitRangeExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -197,7 +196,7 @@ func resourceKubernetesLimitRangeExists(d *schema.ResourceData, meta interface{}
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
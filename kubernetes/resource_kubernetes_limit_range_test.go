@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	api "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func TestAccKubernetesLimitRange_basic(t *testing.T) {
@@ -288,7 +287,7 @@ func TestAccKubernetesLimitRange_importBasic(t *testing.T) {
 }
 
 func testAccCheckKubernetesLimitRangeDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*kubernetes.Clientset)
+	conn := testAccProvider.Meta().(*providerMeta).conn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "kubernetes_limit_range" {
@@ -318,7 +317,7 @@ func testAccCheckKubernetesLimitRangeExists(n string, obj *api.LimitRange) resou
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*kubernetes.Clientset)
+		conn := testAccProvider.Meta().(*providerMeta).conn
 
 		namespace, name, err := idParts(rs.Primary.ID)
 		if err != nil {
@@ -0,0 +1,309 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	kubernetes "k8s.io/client-go/kubernetes"
+)
+
+func resourceKubernetesManifest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesManifestCreate,
+		Read:   resourceKubernetesManifestRead,
+		Exists: resourceKubernetesManifestExists,
+		Update: resourceKubernetesManifestUpdate,
+		Delete: resourceKubernetesManifestDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, meta interface{}) error {
+			if diff.Id() == "" || !diff.HasChange("manifest") {
+				return nil
+			}
+			o, n := diff.GetChange("manifest")
+			if n.(string) == "" {
+				// The new manifest isn't known yet
+				return nil
+			}
+			oldObj, err := parseManifest(o.(string))
+			if err != nil {
+				return nil
+			}
+			newObj, err := parseManifest(n.(string))
+			if err != nil {
+				return err
+			}
+			oldIdentity, _ := manifestObjectIdentity(oldObj)
+			newIdentity, err := manifestObjectIdentity(newObj)
+			if err != nil {
+				return err
+			}
+			config := meta.(*providerMeta).metadata
+			oldIdentity.Namespace = namespaceOrDefault(oldIdentity.Namespace, config)
+			newIdentity.Namespace = namespaceOrDefault(newIdentity.Namespace, config)
+			if oldIdentity != newIdentity {
+				return diff.ForceNew("manifest")
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"manifest": {
+				Type:             schema.TypeString,
+				Description:      "A single Kubernetes object in YAML or JSON format. Changing its apiVersion, kind, namespace or name forces a new resource to be created.",
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentManifests,
+				ValidateFunc: func(value interface{}, key string) (ws []string, es []error) {
+					obj, err := parseManifest(value.(string))
+					if err != nil {
+						es = append(es, fmt.Errorf("%s: %s", key, err))
+						return
+					}
+					if _, err := manifestObjectIdentity(obj); err != nil {
+						es = append(es, fmt.Errorf("%s: %s", key, err))
+					}
+					return
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesManifestCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	obj, err := parseManifest(d.Get("manifest").(string))
+	if err != nil {
+		return err
+	}
+	identity, err := manifestObjectIdentity(obj)
+	if err != nil {
+		return err
+	}
+	resource, err := discoverManifestResource(conn, identity)
+	if err != nil {
+		return err
+	}
+	if resource == nil {
+		return manifestKindNotServedError(identity)
+	}
+	if resource.Namespaced {
+		if identity.Namespace == "" {
+			identity.Namespace = defaultNamespace(meta.(*providerMeta).metadata)
+			obj["metadata"].(map[string]interface{})["namespace"] = identity.Namespace
+		}
+	} else {
+		identity.Namespace = ""
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Creating new %s: %s", identity.Kind, redactedJSON(data))
+	out, err := conn.Discovery().RESTClient().Post().
+		AbsPath(manifestResourcePath(identity.APIVersion, *resource, identity.Namespace, "")).
+		Body(data).
+		DoRaw()
+	if err != nil {
+		return fmt.Errorf("Failed to create %s %q: %s", identity.Kind, identity.Name, err)
+	}
+	log.Printf("[INFO] Submitted new %s: %s", identity.Kind, redactedJSON(out))
+	d.SetId(identity.String())
+
+	return resourceKubernetesManifestRead(d, meta)
+}
+
+func resourceKubernetesManifestRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	identity, err := parseManifestId(d.Id())
+	if err != nil {
+		return err
+	}
+	resource, err := discoverManifestResource(conn, identity)
+	if err != nil {
+		return err
+	}
+	if resource == nil {
+		log.Printf("[WARN] The server doesn't serve %s in %s anymore, removing %s from the state", identity.Kind, identity.APIVersion, identity.Name)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] Reading %s %s", identity.Kind, identity.Name)
+	out, err := conn.Discovery().RESTClient().Get().
+		AbsPath(manifestResourcePath(identity.APIVersion, *resource, identity.Namespace, identity.Name)).
+		DoRaw()
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+		return err
+	}
+	log.Printf("[INFO] Received %s: %s", identity.Kind, redactedJSON(out))
+
+	var live map[string]interface{}
+	err = json.Unmarshal(out, &live)
+	if err != nil {
+		return err
+	}
+
+	var current interface{}
+	if manifest := d.Get("manifest").(string); manifest != "" {
+		obj, err := parseManifest(manifest)
+		if err != nil {
+			return err
+		}
+		current = projectManifest(obj, live)
+	} else {
+		// Imported objects don't have a manifest yet
+		current = cleanLiveObject(live)
+	}
+	data, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+	if suppressEquivalentManifests("manifest", d.Get("manifest").(string), string(data), d) {
+		// Keep the formatting of the configuration when nothing has drifted
+		return nil
+	}
+	log.Printf("[DEBUG] Detected changes in %s: %s", identity.Kind, redactedJSON(data))
+	err = d.Set("manifest", string(data))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesManifestUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	identity, err := parseManifestId(d.Id())
+	if err != nil {
+		return err
+	}
+	resource, err := discoverManifestResource(conn, identity)
+	if err != nil {
+		return err
+	}
+	if resource == nil {
+		return manifestKindNotServedError(identity)
+	}
+
+	o, n := d.GetChange("manifest")
+	oldObj, err := parseManifest(o.(string))
+	if err != nil {
+		return err
+	}
+	newObj, err := parseManifest(n.(string))
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(createManifestMergePatch(oldObj, newObj))
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating %s %q: %s", identity.Kind, identity.Name, redactedJSON(data))
+	out, err := conn.Discovery().RESTClient().Patch(pkgApi.MergePatchType).
+		AbsPath(manifestResourcePath(identity.APIVersion, *resource, identity.Namespace, identity.Name)).
+		Body(data).
+		DoRaw()
+	if err != nil {
+		return fmt.Errorf("Failed to update %s: %s", identity.Kind, err)
+	}
+	log.Printf("[INFO] Submitted updated %s: %s", identity.Kind, redactedJSON(out))
+
+	return resourceKubernetesManifestRead(d, meta)
+}
+
+func resourceKubernetesManifestDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	identity, err := parseManifestId(d.Id())
+	if err != nil {
+		return err
+	}
+	resource, err := discoverManifestResource(conn, identity)
+	if err != nil {
+		return err
+	}
+	if resource == nil {
+		log.Printf("[INFO] The server doesn't serve %s in %s anymore, %s is already gone", identity.Kind, identity.APIVersion, identity.Name)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting %s: %q", identity.Kind, identity.Name)
+	_, err = conn.Discovery().RESTClient().Delete().
+		AbsPath(manifestResourcePath(identity.APIVersion, *resource, identity.Namespace, identity.Name)).
+		DoRaw()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] %s %s deleted", identity.Kind, identity.Name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesManifestExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*providerMeta).conn
+
+	identity, err := parseManifestId(d.Id())
+	if err != nil {
+		return false, err
+	}
+	resource, err := discoverManifestResource(conn, identity)
+	if err != nil {
+		return false, err
+	}
+	if resource == nil {
+		return false, nil
+	}
+
+	log.Printf("[INFO] Checking %s %s", identity.Kind, identity.Name)
+	_, err = conn.Discovery().RESTClient().Get().
+		AbsPath(manifestResourcePath(identity.APIVersion, *resource, identity.Namespace, identity.Name)).
+		DoRaw()
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+	}
+	return true, err
+}
+
+// discoverManifestResource looks up the REST resource serving the given kind.
+// It returns nil when the server doesn't serve the kind, e.g. once the
+// custom resource definition declaring it was deleted.
+func discoverManifestResource(conn *kubernetes.Clientset, identity manifestIdentity) (*meta_v1.APIResource, error) {
+	resources, err := conn.Discovery().ServerResourcesForGroupVersion(identity.APIVersion)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Failed to discover resources of %s: %s", identity.APIVersion, err)
+	}
+	for _, r := range resources.APIResources {
+		// Skip subresources such as deployments/scale
+		if r.Kind == identity.Kind && !strings.Contains(r.Name, "/") {
+			resource := r
+			return &resource, nil
+		}
+	}
+	return nil, nil
+}
+
+func manifestKindNotServedError(identity manifestIdentity) error {
+	return fmt.Errorf("The server doesn't serve kind %q in %s", identity.Kind, identity.APIVersion)
+}
@@ -0,0 +1,226 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestAccKubernetesManifest_basic(t *testing.T) {
+	var conf api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_manifest.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesManifestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesManifestConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesManifestConfigMapExists("kubernetes_manifest.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_manifest.test", "id", fmt.Sprintf("apiVersion=v1,kind=ConfigMap,namespace=default,name=%s", name)),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					testAccCheckConfigMapData(&conf, map[string]string{"one": "first"}),
+				),
+			},
+			{
+				Config: testAccKubernetesManifestConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesManifestConfigMapExists("kubernetes_manifest.test", &conf),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"Different": "1234"}),
+					testAccCheckConfigMapData(&conf, map[string]string{"one": "first", "two": "second"}),
+				),
+			},
+		},
+	})
+}
+
+func TestKubernetesManifest_namespaceChange(t *testing.T) {
+	meta := &providerMeta{metadata: &metadataConfig{defaultNamespace: "platform"}}
+	old := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"web"}}`
+
+	cases := []struct {
+		Namespace   string
+		RequiresNew bool
+	}{
+		{"platform", false},
+		{"default", true},
+	}
+
+	for _, tc := range cases {
+		r := resourceKubernetesManifest()
+		s := &terraform.InstanceState{
+			ID:         "apiVersion=v1,kind=ConfigMap,namespace=platform,name=web",
+			Attributes: map[string]string{"manifest": old},
+		}
+		c, err := config.NewRawConfig(map[string]interface{}{
+			"manifest": fmt.Sprintf(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"web","namespace":%q}}`, tc.Namespace),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		diff, err := r.Diff(s, terraform.NewResourceConfig(c), meta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff.RequiresNew() != tc.RequiresNew {
+			t.Fatalf("Expected moving the manifest to the namespace %q to require a new resource: %t", tc.Namespace, tc.RequiresNew)
+		}
+	}
+}
+
+func TestKubernetesManifest_kindNotServed(t *testing.T) {
+	conn, closeFn := newFakeClientset(t, map[string]runtime.Object{
+		"/apis/example.com/v1": &meta_v1.APIResourceList{
+			GroupVersion: "example.com/v1",
+			APIResources: []meta_v1.APIResource{{Name: "gadgets", Namespaced: true, Kind: "Gadget"}},
+		},
+	})
+	defer closeFn()
+	meta := testProviderMeta(conn)
+
+	ids := []string{
+		// Group version not served anymore
+		"apiVersion=gone.example.com/v1,kind=Widget,namespace=default,name=web",
+		// Kind not served anymore
+		"apiVersion=example.com/v1,kind=Widget,namespace=default,name=web",
+	}
+
+	for _, id := range ids {
+		r := resourceKubernetesManifest()
+		d := r.Data(&terraform.InstanceState{ID: id})
+		exists, err := r.Exists(d, meta)
+		if err != nil {
+			t.Fatalf("Failed to check %s: %s", id, err)
+		}
+		if exists {
+			t.Errorf("Expected %s not to exist", id)
+		}
+		err = r.Read(d, meta)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %s", id, err)
+		}
+		if d.Id() != "" {
+			t.Errorf("Expected %s to be removed from the state, given ID %q", id, d.Id())
+		}
+	}
+}
+
+func TestAccKubernetesManifest_importBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesManifestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesManifestConfig_basic(name),
+			},
+			{
+				ResourceName:      "kubernetes_manifest.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The imported manifest contains every field of the object
+				ImportStateVerifyIgnore: []string{"manifest"},
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesManifestDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*providerMeta).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_manifest" {
+			continue
+		}
+
+		identity, err := parseManifestId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.CoreV1().ConfigMaps(identity.Namespace).Get(identity.Name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Namespace == identity.Namespace && resp.Name == identity.Name {
+				return fmt.Errorf("Manifest still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesManifestConfigMapExists(n string, obj *api.ConfigMap) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*providerMeta).conn
+
+		identity, err := parseManifestId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.CoreV1().ConfigMaps(identity.Namespace).Get(identity.Name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesManifestConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_manifest" "test" {
+  manifest = <<EOF
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %s
+  annotations:
+    TestAnnotationOne: one
+data:
+  one: first
+EOF
+}
+`, name)
+}
+
+func testAccKubernetesManifestConfig_modified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_manifest" "test" {
+  manifest = <<EOF
+{
+  "apiVersion": "v1",
+  "kind": "ConfigMap",
+  "metadata": {
+    "name": "%s",
+    "annotations": {
+      "Different": "1234"
+    }
+  },
+  "data": {
+    "one": "first",
+    "two": "second"
+  }
+}
+EOF
+}
+`, name)
+}
@@ -0,0 +1,131 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesMutatingWebhookConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesMutatingWebhookConfigurationCreate,
+		Read:   resourceKubernetesMutatingWebhookConfigurationRead,
+		Exists: resourceKubernetesMutatingWebhookConfigurationExists,
+		Update: resourceKubernetesMutatingWebhookConfigurationUpdate,
+		Delete: resourceKubernetesMutatingWebhookConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("mutating webhook configuration", true),
+			"webhook":  webhookConfigurationSchema("mutating"),
+		},
+	}
+}
+
+func resourceKubernetesMutatingWebhookConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
+	cfg := api.MutatingWebhookConfiguration{
+		ObjectMeta: metadata,
+		Webhooks:   expandWebhooks(d.Get("webhook").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new mutating webhook configuration: %s", redacted(cfg))
+	out, err := conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Create(&cfg)
+	if err != nil {
+		return fmt.Errorf("Failed to create mutating webhook configuration: %s", err)
+	}
+	log.Printf("[INFO] Submitted new mutating webhook configuration: %s", redacted(out))
+	d.SetId(out.Name)
+
+	return resourceKubernetesMutatingWebhookConfigurationRead(d, meta)
+}
+
+func resourceKubernetesMutatingWebhookConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Reading mutating webhook configuration %s", name)
+	cfg, err := conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+		return err
+	}
+	log.Printf("[INFO] Received mutating webhook configuration: %s", redacted(cfg))
+	err = d.Set("metadata", flattenMetadata(cfg.ObjectMeta, d, meta.(*providerMeta).metadata))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("webhook", flattenWebhooks(cfg.Webhooks))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesMutatingWebhookConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	if d.HasChange("webhook") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/webhooks",
+			Value: expandWebhooks(d.Get("webhook").([]interface{})),
+		})
+	}
+	log.Printf("[INFO] Updating mutating webhook configuration %q: %s", name, redacted(ops))
+	var out *api.MutatingWebhookConfiguration
+	err := sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update mutating webhook configuration: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated mutating webhook configuration: %s", redacted(out))
+	d.SetId(out.Name)
+
+	return resourceKubernetesMutatingWebhookConfigurationRead(d, meta)
+}
+
+func resourceKubernetesMutatingWebhookConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting mutating webhook configuration: %q", name)
+	err := conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Delete(name, &meta_v1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Mutating webhook configuration %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesMutatingWebhookConfigurationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Checking mutating webhook configuration %s", name)
+	_, err := conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+	}
+	return true, err
+}
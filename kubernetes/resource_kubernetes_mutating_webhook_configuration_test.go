@@ -0,0 +1,139 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	api "k8s.io/api/admissionregistration/v1beta1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesMutatingWebhookConfiguration_basic(t *testing.T) {
+	var conf api.MutatingWebhookConfiguration
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_mutating_webhook_configuration.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesMutatingWebhookConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesMutatingWebhookConfigurationConfig_basic(name, "Ignore"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesMutatingWebhookConfigurationExists("kubernetes_mutating_webhook_configuration.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_mutating_webhook_configuration.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_mutating_webhook_configuration.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_mutating_webhook_configuration.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_mutating_webhook_configuration.test", "webhook.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_mutating_webhook_configuration.test", "webhook.0.failure_policy", "Ignore"),
+					resource.TestCheckResourceAttr("kubernetes_mutating_webhook_configuration.test", "webhook.0.client_config.0.service.0.name", "tf-acc-test-webhook"),
+					resource.TestCheckResourceAttr("kubernetes_mutating_webhook_configuration.test", "webhook.0.client_config.0.service.0.namespace", "kube-system"),
+					resource.TestCheckResourceAttr("kubernetes_mutating_webhook_configuration.test", "webhook.0.rule.0.resources.#", "1"),
+				),
+			},
+			{
+				Config: testAccKubernetesMutatingWebhookConfigurationConfig_basic(name, "Fail"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesMutatingWebhookConfigurationExists("kubernetes_mutating_webhook_configuration.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_mutating_webhook_configuration.test", "webhook.0.failure_policy", "Fail"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesMutatingWebhookConfiguration_importBasic(t *testing.T) {
+	resourceName := "kubernetes_mutating_webhook_configuration.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesMutatingWebhookConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesMutatingWebhookConfigurationConfig_basic(name, "Ignore"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesMutatingWebhookConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*providerMeta).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_mutating_webhook_configuration" {
+			continue
+		}
+		name := rs.Primary.ID
+		resp, err := conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Mutating webhook configuration still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesMutatingWebhookConfigurationExists(n string, obj *api.MutatingWebhookConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*providerMeta).conn
+		name := rs.Primary.ID
+		out, err := conn.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+// The webhook only matches namespaces labelled with the name of the test,
+// so it doesn't interfere with other tests even if failures aren't ignored
+func testAccKubernetesMutatingWebhookConfigurationConfig_basic(name, failurePolicy string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_mutating_webhook_configuration" "test" {
+  metadata {
+    name = "%s"
+  }
+  webhook {
+    name = "pods.tf-acc-test.example.com"
+    client_config {
+      service {
+        namespace = "kube-system"
+        name      = "tf-acc-test-webhook"
+      }
+    }
+    rule {
+      api_groups   = [""]
+      api_versions = ["v1"]
+      operations   = ["CREATE"]
+      resources    = ["pods"]
+    }
+    failure_policy = "%s"
+    namespace_selector {
+      match_labels {
+        tf-acc-test = "%s"
+      }
+    }
+  }
+}
+`, name, failurePolicy, name)
+}
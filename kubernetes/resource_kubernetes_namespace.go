@@ -11,7 +11,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesNamespace() *schema.Resource {
@@ -32,35 +31,35 @@ func resourceKubernetesNamespace() *schema.Resource {
 }
 
 func resourceKubernetesNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	namespace := api.Namespace{
 		ObjectMeta: metadata,
 	}
-	log.Printf("[INFO] Creating new namespace: %#v", namespace)
+	log.Printf("[INFO] Creating new namespace: %s", redacted(namespace))
 	out, err := conn.CoreV1().Namespaces().Create(&namespace)
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new namespace: %#v", out)
+	log.Printf("[INFO] Submitted new namespace: %s", redacted(out))
 	d.SetId(out.Name)
 
 	return resourceKubernetesNamespaceRead(d, meta)
 }
 
 func resourceKubernetesNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Reading namespace %s", name)
 	namespace, err := conn.CoreV1().Namespaces().Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received namespace: %#v", namespace)
-	err = d.Set("metadata", flattenMetadata(namespace.ObjectMeta))
+	log.Printf("[INFO] Received namespace: %s", redacted(namespace))
+	err = d.Set("metadata", flattenMetadata(namespace.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
@@ -69,30 +68,29 @@ func resourceKubernetesNamespaceRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-
-	log.Printf("[INFO] Updating namespace: %s", ops)
-	out, err := conn.CoreV1().Namespaces().Patch(d.Id(), pkgApi.JSONPatchType, data)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	log.Printf("[INFO] Updating namespace: %s", redacted(ops))
+	var out *api.Namespace
+	err := sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.CoreV1().Namespaces().Patch(d.Id(), pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted updated namespace: %#v", out)
+	log.Printf("[INFO] Submitted updated namespace: %s", redacted(out))
 	d.SetId(out.Name)
 
 	return resourceKubernetesNamespaceRead(d, meta)
 }
 
 func resourceKubernetesNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
-	log.Printf("[INFO] Deleting namespace: %#v", name)
+	log.Printf("[INFO] Deleting namespace: %q", name)
 	err := conn.CoreV1().Namespaces().Delete(name, &meta_v1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -108,12 +106,12 @@ func resourceKubernetesNamespaceDelete(d *schema.ResourceData, meta interface{})
 				if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 					return nil, "", nil
 				}
-				log.Printf("[ERROR] Received error: %#v", err)
+				log.Printf("[ERROR] Received error: %s", redacted(err))
 				return out, "Error", err
 			}
 
 			statusPhase := fmt.Sprintf("%v", out.Status.Phase)
-			log.Printf("[DEBUG] Namespace %s status received: %#v", out.Name, statusPhase)
+			log.Printf("[DEBUG] Namespace %s status received: %q", out.Name, statusPhase)
 			return out, statusPhase, nil
 		},
 	}
@@ -128,7 +126,7 @@ func resourceKubernetesNamespaceDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesNamespaceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Checking namespace %s", name)
@@ -137,7 +135,7 @@ func resourceKubernetesNamespaceExists(d *schema.ResourceData, meta interface{})
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	log.Printf("[INFO] Namespace %s exists", name)
 	return true, err
@@ -0,0 +1,282 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesNetworkPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesNetworkPolicyCreate,
+		Read:   resourceKubernetesNetworkPolicyRead,
+		Exists: resourceKubernetesNetworkPolicyExists,
+		Update: resourceKubernetesNetworkPolicyUpdate,
+		Delete: resourceKubernetesNetworkPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("network policy", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the behavior of a network policy. More info: https://kubernetes.io/docs/concepts/services-networking/network-policies/",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: networkPolicySpecFields(),
+				},
+			},
+		},
+	}
+}
+
+func networkPolicySpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"egress": {
+			Type:        schema.TypeList,
+			Description: "List of egress rules to be applied to the selected pods. Outgoing traffic is allowed if there are no NetworkPolicies selecting the pod, or if the traffic matches at least one egress rule across all of the NetworkPolicy objects whose pod_selector matches the pod.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ports": networkPolicyPortsSchema("outgoing"),
+					"to": {
+						Type:        schema.TypeList,
+						Description: "List of destinations for outgoing traffic of pods selected for this rule. If this field is empty, this rule matches all destinations.",
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: networkPolicyPeerFields(),
+						},
+					},
+				},
+			},
+		},
+		"ingress": {
+			Type:        schema.TypeList,
+			Description: "List of ingress rules to be applied to the selected pods. Traffic is allowed to a pod if there are no NetworkPolicies selecting the pod, or if the traffic source is the pod's local node, or if the traffic matches at least one ingress rule across all of the NetworkPolicy objects whose pod_selector matches the pod.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"from": {
+						Type:        schema.TypeList,
+						Description: "List of sources which should be able to access the pods selected for this rule. If this field is empty, this rule matches all sources.",
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: networkPolicyPeerFields(),
+						},
+					},
+					"ports": networkPolicyPortsSchema("incoming"),
+				},
+			},
+		},
+		"pod_selector": {
+			Type:        schema.TypeList,
+			Description: "Selects the pods to which this network policy applies. An empty selector selects all pods in the namespace.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(true),
+			},
+		},
+		"policy_types": {
+			Type:        schema.TypeList,
+			Description: "List of rule types that the network policy relates to. Valid options are `Ingress`, `Egress`, or both.",
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAttributeValueIsIn([]string{"Ingress", "Egress"}),
+			},
+		},
+	}
+}
+
+func networkPolicyPortsSchema(direction string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: fmt.Sprintf("List of ports which should be made accessible for %s traffic. If this field is empty, this rule matches all ports.", direction),
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"port": {
+					Type:        schema.TypeString,
+					Description: "The port on the given protocol. This can either be a numerical or named port on a pod. If this field is not provided, this matches all port names and numbers.",
+					Optional:    true,
+				},
+				"protocol": {
+					Type:         schema.TypeString,
+					Description:  "The protocol (TCP or UDP) which traffic must match. Defaults to TCP.",
+					Optional:     true,
+					Default:      "TCP",
+					ValidateFunc: validateAttributeValueIsIn([]string{"TCP", "UDP"}),
+				},
+			},
+		},
+	}
+}
+
+func networkPolicyPeerFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ip_block": {
+			Type:        schema.TypeList,
+			Description: "Selects a particular CIDR range which is allowed or denied.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cidr": {
+						Type:        schema.TypeString,
+						Description: "A string representing the IP Block, e.g. `192.168.1.1/24`.",
+						Required:    true,
+					},
+					"except": {
+						Type:        schema.TypeList,
+						Description: "A list of CIDRs which should not be included within the IP Block, e.g. `192.168.1.1/24`. Except values will be rejected if they are outside the CIDR range.",
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"namespace_selector": {
+			Type:        schema.TypeList,
+			Description: "Selects namespaces using cluster scoped labels. If present but empty, this selector selects all namespaces.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(true),
+			},
+		},
+		"pod_selector": {
+			Type:        schema.TypeList,
+			Description: "Selects pods in the same namespace as the network policy. If present but empty, this selector selects all pods in this namespace.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(true),
+			},
+		},
+	}
+}
+
+func resourceKubernetesNetworkPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
+	policy := api.NetworkPolicy{
+		ObjectMeta: metadata,
+		Spec:       expandNetworkPolicySpec(d.Get("spec").([]interface{})),
+	}
+	log.Printf("[INFO] Creating new network policy: %s", redacted(policy))
+	out, err := conn.NetworkingV1().NetworkPolicies(metadata.Namespace).Create(&policy)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new network policy: %s", redacted(out))
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesNetworkPolicyRead(d, meta)
+}
+
+func resourceKubernetesNetworkPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading network policy %s", name)
+	policy, err := conn.NetworkingV1().NetworkPolicies(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+		return err
+	}
+	log.Printf("[INFO] Received network policy: %s", redacted(policy))
+	err = d.Set("metadata", flattenMetadata(policy.ObjectMeta, d, meta.(*providerMeta).metadata))
+	if err != nil {
+		return err
+	}
+
+	flattened := flattenNetworkPolicySpec(policy.Spec)
+	log.Printf("[DEBUG] Flattened network policy spec: %s", redacted(flattened))
+	err = d.Set("spec", flattened)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesNetworkPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	if d.HasChange("spec") {
+		specOps := patchNetworkPolicySpec("/spec", "spec.0.", d)
+		ops = append(ops, specOps...)
+	}
+	log.Printf("[INFO] Updating network policy %q: %s", name, redacted(ops))
+	var out *api.NetworkPolicy
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.NetworkingV1().NetworkPolicies(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update network policy: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated network policy: %s", redacted(out))
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesNetworkPolicyRead(d, meta)
+}
+
+func resourceKubernetesNetworkPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting network policy: %q", name)
+	err = conn.NetworkingV1().NetworkPolicies(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Network policy %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesNetworkPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*providerMeta).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking network policy %s", name)
+	_, err = conn.NetworkingV1().NetworkPolicies(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+	}
+	return true, err
+}
@@ -12,7 +12,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesPersistentVolume() *schema.Resource {
@@ -34,7 +33,7 @@ func resourceKubernetesPersistentVolume() *schema.Resource {
 
 			// Mutation of PersistentVolumeSource after creation is no longer allowed in 1.9+
 			// See https://github.com/kubernetes/kubernetes/blob/v1.9.3/CHANGELOG-1.9.md#storage-3
-			conn := meta.(*kubernetes.Clientset)
+			conn := meta.(*providerMeta).conn
 			serverVersion, err := conn.ServerVersion()
 			if err != nil {
 				return err
@@ -112,9 +111,9 @@ func resourceKubernetesPersistentVolume() *schema.Resource {
 }
 
 func resourceKubernetesPersistentVolumeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	spec, err := expandPersistentVolumeSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return err
@@ -124,12 +123,12 @@ func resourceKubernetesPersistentVolumeCreate(d *schema.ResourceData, meta inter
 		Spec:       spec,
 	}
 
-	log.Printf("[INFO] Creating new persistent volume: %#v", volume)
+	log.Printf("[INFO] Creating new persistent volume: %s", redacted(volume))
 	out, err := conn.CoreV1().PersistentVolumes().Create(&volume)
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new persistent volume: %#v", out)
+	log.Printf("[INFO] Submitted new persistent volume: %s", redacted(out))
 
 	stateConf := &resource.StateChangeConf{
 		Target:  []string{"Available", "Bound"},
@@ -138,12 +137,12 @@ func resourceKubernetesPersistentVolumeCreate(d *schema.ResourceData, meta inter
 		Refresh: func() (interface{}, string, error) {
 			out, err := conn.CoreV1().PersistentVolumes().Get(metadata.Name, meta_v1.GetOptions{})
 			if err != nil {
-				log.Printf("[ERROR] Received error: %#v", err)
+				log.Printf("[ERROR] Received error: %s", redacted(err))
 				return out, "Error", err
 			}
 
 			statusPhase := fmt.Sprintf("%v", out.Status.Phase)
-			log.Printf("[DEBUG] Persistent volume %s status received: %#v", out.Name, statusPhase)
+			log.Printf("[DEBUG] Persistent volume %s status received: %q", out.Name, statusPhase)
 			return out, statusPhase, nil
 		},
 	}
@@ -159,17 +158,17 @@ func resourceKubernetesPersistentVolumeCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceKubernetesPersistentVolumeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Reading persistent volume %s", name)
 	volume, err := conn.CoreV1().PersistentVolumes().Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received persistent volume: %#v", volume)
-	err = d.Set("metadata", flattenMetadata(volume.ObjectMeta))
+	log.Printf("[INFO] Received persistent volume: %s", redacted(volume))
+	err = d.Set("metadata", flattenMetadata(volume.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
@@ -182,9 +181,9 @@ func resourceKubernetesPersistentVolumeRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceKubernetesPersistentVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		specOps, err := patchPersistentVolumeSpec("/spec", "spec", d)
 		if err != nil {
@@ -192,27 +191,26 @@ func resourceKubernetesPersistentVolumeUpdate(d *schema.ResourceData, meta inter
 		}
 		ops = append(ops, specOps...)
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-
-	log.Printf("[INFO] Updating persistent volume %s: %s", d.Id(), ops)
-	out, err := conn.CoreV1().PersistentVolumes().Patch(d.Id(), pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating persistent volume %s: %s", d.Id(), redacted(ops))
+	var out *api.PersistentVolume
+	err := sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.CoreV1().PersistentVolumes().Patch(d.Id(), pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted updated persistent volume: %#v", out)
+	log.Printf("[INFO] Submitted updated persistent volume: %s", redacted(out))
 	d.SetId(out.Name)
 
 	return resourceKubernetesPersistentVolumeRead(d, meta)
 }
 
 func resourceKubernetesPersistentVolumeDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
-	log.Printf("[INFO] Deleting persistent volume: %#v", name)
+	log.Printf("[INFO] Deleting persistent volume: %q", name)
 	err := conn.CoreV1().PersistentVolumes().Delete(name, &meta_v1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -225,7 +223,7 @@ func resourceKubernetesPersistentVolumeDelete(d *schema.ResourceData, meta inter
 }
 
 func resourceKubernetesPersistentVolumeExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Checking persistent volume %s", name)
@@ -234,7 +232,7 @@ func resourceKubernetesPersistentVolumeExists(d *schema.ResourceData, meta inter
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
@@ -11,7 +11,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
@@ -80,7 +79,7 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 							ForceNew:    true,
 							MaxItems:    1,
 							Elem: &schema.Resource{
-								Schema: labelSelectorFields(),
+								Schema: labelSelectorFields(false),
 							},
 						},
 						"volume_name": {
@@ -111,9 +110,9 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 }
 
 func resourceKubernetesPersistentVolumeClaimCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	spec, err := expandPersistentVolumeClaimSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return err
@@ -124,12 +123,12 @@ func resourceKubernetesPersistentVolumeClaimCreate(d *schema.ResourceData, meta
 		Spec:       spec,
 	}
 
-	log.Printf("[INFO] Creating new persistent volume claim: %#v", claim)
+	log.Printf("[INFO] Creating new persistent volume claim: %s", redacted(claim))
 	out, err := conn.CoreV1().PersistentVolumeClaims(metadata.Namespace).Create(&claim)
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new persistent volume claim: %#v", out)
+	log.Printf("[INFO] Submitted new persistent volume claim: %s", redacted(out))
 
 	d.SetId(buildId(out.ObjectMeta))
 	name := out.ObjectMeta.Name
@@ -142,12 +141,12 @@ func resourceKubernetesPersistentVolumeClaimCreate(d *schema.ResourceData, meta
 			Refresh: func() (interface{}, string, error) {
 				out, err := conn.CoreV1().PersistentVolumeClaims(metadata.Namespace).Get(name, meta_v1.GetOptions{})
 				if err != nil {
-					log.Printf("[ERROR] Received error: %#v", err)
+					log.Printf("[ERROR] Received error: %s", redacted(err))
 					return out, "", err
 				}
 
 				statusPhase := fmt.Sprintf("%v", out.Status.Phase)
-				log.Printf("[DEBUG] Persistent volume claim %s status received: %#v", out.Name, statusPhase)
+				log.Printf("[DEBUG] Persistent volume claim %s status received: %q", out.Name, statusPhase)
 				return out, statusPhase, nil
 			},
 		}
@@ -179,7 +178,7 @@ func resourceKubernetesPersistentVolumeClaimCreate(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesPersistentVolumeClaimRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -189,11 +188,11 @@ func resourceKubernetesPersistentVolumeClaimRead(d *schema.ResourceData, meta in
 	log.Printf("[INFO] Reading persistent volume claim %s", name)
 	claim, err := conn.CoreV1().PersistentVolumeClaims(namespace).Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received persistent volume claim: %#v", claim)
-	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta))
+	log.Printf("[INFO] Received persistent volume claim: %s", redacted(claim))
+	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
@@ -206,39 +205,38 @@ func resourceKubernetesPersistentVolumeClaimRead(d *schema.ResourceData, meta in
 }
 
 func resourceKubernetesPersistentVolumeClaimUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	// The whole spec is ForceNew = nothing to update there
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-
-	log.Printf("[INFO] Updating persistent volume claim: %s", ops)
-	out, err := conn.CoreV1().PersistentVolumeClaims(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating persistent volume claim: %s", redacted(ops))
+	var out *api.PersistentVolumeClaim
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.CoreV1().PersistentVolumeClaims(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted updated persistent volume claim: %#v", out)
+	log.Printf("[INFO] Submitted updated persistent volume claim: %s", redacted(out))
 
 	return resourceKubernetesPersistentVolumeClaimRead(d, meta)
 }
 
 func resourceKubernetesPersistentVolumeClaimDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Deleting persistent volume claim: %#v", name)
+	log.Printf("[INFO] Deleting persistent volume claim: %q", name)
 	err = conn.CoreV1().PersistentVolumeClaims(namespace).Delete(name, &meta_v1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -251,7 +249,7 @@ func resourceKubernetesPersistentVolumeClaimDelete(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesPersistentVolumeClaimExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -264,7 +262,7 @@ func resourceKubernetesPersistentVolumeClaimExists(d *schema.ResourceData, meta
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
@@ -11,7 +11,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesPod() *schema.Resource {
@@ -32,6 +31,12 @@ func resourceKubernetesPod() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("pod", true),
+			"grace_period_seconds": {
+				Type:         schema.TypeInt,
+				Description:  "Duration in seconds the pod is given to terminate gracefully on deletion, 0 deleting it immediately. Defaults to the termination grace period of the pod spec.",
+				Optional:     true,
+				ValidateFunc: validateTerminationGracePeriodSeconds,
+			},
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec of the pod owned by the cluster",
@@ -45,10 +50,10 @@ func resourceKubernetesPod() *schema.Resource {
 	}
 }
 func resourceKubernetesPodCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandPodSpec(d.Get("spec").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
+	spec, err := expandPodSpec(d.Get("spec").([]interface{}), d, "spec.0.")
 	if err != nil {
 		return err
 	}
@@ -60,13 +65,13 @@ func resourceKubernetesPodCreate(d *schema.ResourceData, meta interface{}) error
 		Spec:       spec,
 	}
 
-	log.Printf("[INFO] Creating new pod: %#v", pod)
+	log.Printf("[INFO] Creating new pod: %s", redacted(pod))
 	out, err := conn.CoreV1().Pods(metadata.Namespace).Create(&pod)
 
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new pod: %#v", out)
+	log.Printf("[INFO] Submitted new pod: %s", redacted(out))
 
 	d.SetId(buildId(out.ObjectMeta))
 
@@ -77,12 +82,12 @@ func resourceKubernetesPodCreate(d *schema.ResourceData, meta interface{}) error
 		Refresh: func() (interface{}, string, error) {
 			out, err := conn.CoreV1().Pods(metadata.Namespace).Get(metadata.Name, metav1.GetOptions{})
 			if err != nil {
-				log.Printf("[ERROR] Received error: %#v", err)
+				log.Printf("[ERROR] Received error: %s", redacted(err))
 				return out, "Error", err
 			}
 
 			statusPhase := fmt.Sprintf("%v", out.Status.Phase)
-			log.Printf("[DEBUG] Pods %s status received: %#v", out.Name, statusPhase)
+			log.Printf("[DEBUG] Pods %s status received: %q", out.Name, statusPhase)
 			return out, statusPhase, nil
 		},
 	}
@@ -100,14 +105,14 @@ func resourceKubernetesPodCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceKubernetesPodUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		specOps, err := patchPodSpec("/spec", "spec.0.", d)
 		if err != nil {
@@ -115,25 +120,24 @@ func resourceKubernetesPodUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		ops = append(ops, specOps...)
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
+	log.Printf("[INFO] Updating pod %s: %s", d.Id(), redacted(ops))
 
-	log.Printf("[INFO] Updating pod %s: %s", d.Id(), ops)
-
-	out, err := conn.CoreV1().Pods(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	var out *api.Pod
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.CoreV1().Pods(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted updated pod: %#v", out)
+	log.Printf("[INFO] Submitted updated pod: %s", redacted(out))
 
 	d.SetId(buildId(out.ObjectMeta))
 	return resourceKubernetesPodRead(d, meta)
 }
 
 func resourceKubernetesPodRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -143,12 +147,12 @@ func resourceKubernetesPodRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Reading pod %s", name)
 	pod, err := conn.CoreV1().Pods(namespace).Get(name, metav1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received pod: %#v", pod)
+	log.Printf("[INFO] Received pod: %s", redacted(pod))
 
-	err = d.Set("metadata", flattenMetadata(pod.ObjectMeta))
+	err = d.Set("metadata", flattenMetadata(pod.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
@@ -167,31 +171,27 @@ func resourceKubernetesPodRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceKubernetesPodDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Deleting pod: %#v", name)
-	err = conn.CoreV1().Pods(namespace).Delete(name, nil)
+	log.Printf("[INFO] Deleting pod: %q", name)
+	opts := &metav1.DeleteOptions{}
+	// 0 is a meaningful grace period, it has to be told apart from unset
+	if v, ok := d.GetOkExists("grace_period_seconds"); ok {
+		opts.GracePeriodSeconds = ptrToInt64(int64(v.(int)))
+	}
+	err = conn.CoreV1().Pods(namespace).Delete(name, opts)
 	if err != nil {
 		return err
 	}
 
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		out, err := conn.CoreV1().Pods(namespace).Get(name, metav1.GetOptions{})
-		if err != nil {
-			if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
-				return nil
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		log.Printf("[DEBUG] Current state of pod: %#v", out.Status.Phase)
-		e := fmt.Errorf("Pod %s still exists (%s)", name, out.Status.Phase)
-		return resource.RetryableError(e)
+	err = waitForDeletion("Pod", name, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := conn.CoreV1().Pods(namespace).Get(name, metav1.GetOptions{})
+		return err
 	})
 	if err != nil {
 		return err
@@ -204,7 +204,7 @@ func resourceKubernetesPodDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceKubernetesPodExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -217,7 +217,7 @@ func resourceKubernetesPodExists(d *schema.ResourceData, meta interface{}) (bool
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
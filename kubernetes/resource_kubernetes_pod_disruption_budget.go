@@ -0,0 +1,204 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesPodDisruptionBudget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesPodDisruptionBudgetCreate,
+		Read:   resourceKubernetesPodDisruptionBudgetRead,
+		Exists: resourceKubernetesPodDisruptionBudgetExists,
+		Update: resourceKubernetesPodDisruptionBudgetUpdate,
+		Delete: resourceKubernetesPodDisruptionBudgetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("pod disruption budget", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the behavior of a pod disruption budget. More info: https://kubernetes.io/docs/concepts/workloads/pods/disruptions/",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_unavailable": {
+							Type:          schema.TypeString,
+							Description:   "An eviction is allowed if at most this number (or percentage) of the selected pods are unavailable after the eviction. Conflicts with `min_available`.",
+							Optional:      true,
+							ConflictsWith: []string{"spec.0.min_available"},
+							ValidateFunc:  validateIntOrPercent,
+						},
+						"min_available": {
+							Type:          schema.TypeString,
+							Description:   "An eviction is allowed if at least this number (or percentage) of the selected pods will still be available after the eviction. Conflicts with `max_unavailable`.",
+							Optional:      true,
+							ConflictsWith: []string{"spec.0.max_unavailable"},
+							ValidateFunc:  validateIntOrPercent,
+						},
+						"selector": {
+							Type:        schema.TypeList,
+							Description: "A label query over the pods whose evictions are managed by the disruption budget.",
+							Required:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: labelSelectorFields(false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesPodDisruptionBudgetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
+	spec, err := expandPodDisruptionBudgetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return err
+	}
+	pdb := api.PodDisruptionBudget{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}
+	log.Printf("[INFO] Creating new pod disruption budget: %s", redacted(pdb))
+	out, err := conn.PolicyV1beta1().PodDisruptionBudgets(metadata.Namespace).Create(&pdb)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new pod disruption budget: %s", redacted(out))
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesPodDisruptionBudgetRead(d, meta)
+}
+
+func resourceKubernetesPodDisruptionBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading pod disruption budget %s", name)
+	pdb, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+		return err
+	}
+	log.Printf("[INFO] Received pod disruption budget: %s", redacted(pdb))
+	err = d.Set("metadata", flattenMetadata(pdb.ObjectMeta, d, meta.(*providerMeta).metadata))
+	if err != nil {
+		return err
+	}
+
+	flattened := flattenPodDisruptionBudgetSpec(pdb.Spec)
+	log.Printf("[DEBUG] Flattened pod disruption budget spec: %s", redacted(flattened))
+	err = d.Set("spec", flattened)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesPodDisruptionBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	if d.HasChange("spec") {
+		ops = append(ops, patchPodDisruptionBudgetSpec("/spec", "spec.0.", d)...)
+	}
+	log.Printf("[INFO] Updating pod disruption budget %q: %s", name, redacted(ops))
+	var out *api.PodDisruptionBudget
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		if d.HasChange("spec") && isImmutablePodDisruptionBudgetSpecError(err) {
+			log.Printf("[INFO] The spec of pod disruption budget %q can't be updated, recreating it", name)
+			return resourceKubernetesPodDisruptionBudgetRecreate(d, meta)
+		}
+		return fmt.Errorf("Failed to update pod disruption budget: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated pod disruption budget: %s", redacted(out))
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesPodDisruptionBudgetRead(d, meta)
+}
+
+// resourceKubernetesPodDisruptionBudgetRecreate replaces the pod disruption
+// budget, for clusters older than Kubernetes 1.15 which don't allow to update
+// its spec
+func resourceKubernetesPodDisruptionBudgetRecreate(d *schema.ResourceData, meta interface{}) error {
+	err := resourceKubernetesPodDisruptionBudgetDelete(d, meta)
+	if err != nil {
+		return err
+	}
+	return resourceKubernetesPodDisruptionBudgetCreate(d, meta)
+}
+
+// isImmutablePodDisruptionBudgetSpecError tells whether the server rejected
+// the update because the spec of pod disruption budgets is immutable
+func isImmutablePodDisruptionBudgetSpecError(err error) bool {
+	return errors.IsInvalid(err) && strings.Contains(err.Error(), "updates to poddisruptionbudget spec are forbidden")
+}
+
+func resourceKubernetesPodDisruptionBudgetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting pod disruption budget: %q", name)
+	err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Pod disruption budget %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesPodDisruptionBudgetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*providerMeta).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking pod disruption budget %s", name)
+	_, err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+	}
+	return true, err
+}
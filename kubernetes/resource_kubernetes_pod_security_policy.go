@@ -0,0 +1,141 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesPodSecurityPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesPodSecurityPolicyCreate,
+		Read:   resourceKubernetesPodSecurityPolicyRead,
+		Exists: resourceKubernetesPodSecurityPolicyExists,
+		Update: resourceKubernetesPodSecurityPolicyUpdate,
+		Delete: resourceKubernetesPodSecurityPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("pod security policy", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the policy enforced on the pods. More info: https://kubernetes.io/docs/concepts/policy/pod-security-policy/",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: podSecurityPolicySpecFields(),
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesPodSecurityPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
+	policy := api.PodSecurityPolicy{
+		ObjectMeta: metadata,
+		Spec:       expandPodSecurityPolicySpec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new pod security policy: %s", redacted(policy))
+	out, err := conn.ExtensionsV1beta1().PodSecurityPolicies().Create(&policy)
+	if err != nil {
+		return fmt.Errorf("Failed to create pod security policy: %s", err)
+	}
+	log.Printf("[INFO] Submitted new pod security policy: %s", redacted(out))
+	d.SetId(out.Name)
+
+	return resourceKubernetesPodSecurityPolicyRead(d, meta)
+}
+
+func resourceKubernetesPodSecurityPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Reading pod security policy %s", name)
+	policy, err := conn.ExtensionsV1beta1().PodSecurityPolicies().Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+		return err
+	}
+	log.Printf("[INFO] Received pod security policy: %s", redacted(policy))
+	err = d.Set("metadata", flattenMetadata(policy.ObjectMeta, d, meta.(*providerMeta).metadata))
+	if err != nil {
+		return err
+	}
+
+	flattened := flattenPodSecurityPolicySpec(policy.Spec)
+	log.Printf("[DEBUG] Flattened pod security policy spec: %s", redacted(flattened))
+	err = d.Set("spec", flattened)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesPodSecurityPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandPodSecurityPolicySpec(d.Get("spec").([]interface{})),
+		})
+	}
+	log.Printf("[INFO] Updating pod security policy %q: %s", name, redacted(ops))
+	var out *api.PodSecurityPolicy
+	err := sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.ExtensionsV1beta1().PodSecurityPolicies().Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update pod security policy: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated pod security policy: %s", redacted(out))
+	d.SetId(out.Name)
+
+	return resourceKubernetesPodSecurityPolicyRead(d, meta)
+}
+
+func resourceKubernetesPodSecurityPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting pod security policy: %q", name)
+	err := conn.ExtensionsV1beta1().PodSecurityPolicies().Delete(name, &meta_v1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Pod security policy %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesPodSecurityPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Checking pod security policy %s", name)
+	_, err := conn.ExtensionsV1beta1().PodSecurityPolicies().Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+	}
+	return true, err
+}
@@ -0,0 +1,156 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/scheduling/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesPriorityClass() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesPriorityClassCreate,
+		Read:   resourceKubernetesPriorityClassRead,
+		Exists: resourceKubernetesPriorityClassExists,
+		Update: resourceKubernetesPriorityClassUpdate,
+		Delete: resourceKubernetesPriorityClassDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("priority class", true),
+			"description": {
+				Type:        schema.TypeString,
+				Description: "An arbitrary string that usually provides guidelines on when this priority class should be used.",
+				Optional:    true,
+			},
+			"global_default": {
+				Type:        schema.TypeBool,
+				Description: "Specifies whether this priority class should be considered as the default priority for pods that do not have any priority class. Only one priority class can be marked as `global_default`.",
+				Optional:    true,
+				Default:     false,
+			},
+			"value": {
+				Type:        schema.TypeInt,
+				Description: "The value of this priority class. This is the actual priority that pods receive when they have the name of this class in their pod spec. Cannot be updated.",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesPriorityClassCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
+	priorityClass := api.PriorityClass{
+		ObjectMeta:    metadata,
+		Description:   d.Get("description").(string),
+		GlobalDefault: d.Get("global_default").(bool),
+		Value:         int32(d.Get("value").(int)),
+	}
+
+	log.Printf("[INFO] Creating new priority class: %s", redacted(priorityClass))
+	out, err := conn.SchedulingV1alpha1().PriorityClasses().Create(&priorityClass)
+	if err != nil {
+		return fmt.Errorf("Failed to create priority class: %s", err)
+	}
+	log.Printf("[INFO] Submitted new priority class: %s", redacted(out))
+	d.SetId(out.Name)
+
+	return resourceKubernetesPriorityClassRead(d, meta)
+}
+
+func resourceKubernetesPriorityClassRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Reading priority class %s", name)
+	priorityClass, err := conn.SchedulingV1alpha1().PriorityClasses().Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+		return err
+	}
+	log.Printf("[INFO] Received priority class: %s", redacted(priorityClass))
+	err = d.Set("metadata", flattenMetadata(priorityClass.ObjectMeta, d, meta.(*providerMeta).metadata))
+	if err != nil {
+		return err
+	}
+
+	d.Set("description", priorityClass.Description)
+	d.Set("global_default", priorityClass.GlobalDefault)
+	d.Set("value", priorityClass.Value)
+
+	return nil
+}
+
+func resourceKubernetesPriorityClassUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	// Both fields are omitted from the object when empty, and "add"
+	// replaces the value when it's set
+	if d.HasChange("description") {
+		ops = append(ops, &AddOperation{
+			Path:  "/description",
+			Value: d.Get("description").(string),
+		})
+	}
+	if d.HasChange("global_default") {
+		ops = append(ops, &AddOperation{
+			Path:  "/globalDefault",
+			Value: d.Get("global_default").(bool),
+		})
+	}
+	log.Printf("[INFO] Updating priority class %q: %s", name, redacted(ops))
+	var out *api.PriorityClass
+	err := sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.SchedulingV1alpha1().PriorityClasses().Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update priority class: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated priority class: %s", redacted(out))
+	d.SetId(out.Name)
+
+	return resourceKubernetesPriorityClassRead(d, meta)
+}
+
+func resourceKubernetesPriorityClassDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting priority class: %q", name)
+	err := conn.SchedulingV1alpha1().PriorityClasses().Delete(name, &meta_v1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Priority class %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesPriorityClassExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Checking priority class %s", name)
+	_, err := conn.SchedulingV1alpha1().PriorityClasses().Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+	}
+	return true, err
+}
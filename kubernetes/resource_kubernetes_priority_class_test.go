@@ -0,0 +1,125 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	api "k8s.io/api/scheduling/v1alpha1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesPriorityClass_basic(t *testing.T) {
+	var conf api.PriorityClass
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_priority_class.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesPriorityClassDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPriorityClassConfig_basic(name, "Batch jobs", 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPriorityClassExists("kubernetes_priority_class.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_priority_class.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_priority_class.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_priority_class.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_priority_class.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_priority_class.test", "value", "100"),
+					resource.TestCheckResourceAttr("kubernetes_priority_class.test", "description", "Batch jobs"),
+					resource.TestCheckResourceAttr("kubernetes_priority_class.test", "global_default", "false"),
+				),
+			},
+			{
+				Config: testAccKubernetesPriorityClassConfig_basic(name, "Background batch jobs", 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPriorityClassExists("kubernetes_priority_class.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_priority_class.test", "value", "100"),
+					resource.TestCheckResourceAttr("kubernetes_priority_class.test", "description", "Background batch jobs"),
+				),
+			},
+			{
+				Config: testAccKubernetesPriorityClassConfig_basic(name, "Background batch jobs", 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPriorityClassExists("kubernetes_priority_class.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_priority_class.test", "value", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPriorityClass_importBasic(t *testing.T) {
+	resourceName := "kubernetes_priority_class.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPriorityClassDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPriorityClassConfig_basic(name, "Batch jobs", 100),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesPriorityClassDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*providerMeta).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_priority_class" {
+			continue
+		}
+		name := rs.Primary.ID
+		resp, err := conn.SchedulingV1alpha1().PriorityClasses().Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Priority class still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesPriorityClassExists(n string, obj *api.PriorityClass) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*providerMeta).conn
+		name := rs.Primary.ID
+		out, err := conn.SchedulingV1alpha1().PriorityClasses().Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesPriorityClassConfig_basic(name, description string, value int) string {
+	return fmt.Sprintf(`
+resource "kubernetes_priority_class" "test" {
+  metadata {
+    name = "%s"
+  }
+  description = "%s"
+  value       = %d
+}
+`, name, description, value)
+}
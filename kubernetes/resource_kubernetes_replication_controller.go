@@ -32,7 +32,8 @@ func resourceKubernetesReplicationController() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("replication controller", true),
+			"metadata":                  namespacedMetadataSchema("replication controller", true),
+			"delete_propagation_policy": deletePropagationPolicySchema("replication controller"),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec defines the specification of the desired behavior of the replication controller. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#spec-and-status",
@@ -74,10 +75,10 @@ func resourceKubernetesReplicationController() *schema.Resource {
 }
 
 func resourceKubernetesReplicationControllerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
+	spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}), d)
 	if err != nil {
 		return err
 	}
@@ -89,7 +90,7 @@ func resourceKubernetesReplicationControllerCreate(d *schema.ResourceData, meta
 		Spec:       spec,
 	}
 
-	log.Printf("[INFO] Creating new replication controller: %#v", rc)
+	log.Printf("[INFO] Creating new replication controller: %s", redacted(rc))
 	out, err := conn.CoreV1().ReplicationControllers(metadata.Namespace).Create(&rc)
 	if err != nil {
 		return fmt.Errorf("Failed to create replication controller: %s", err)
@@ -109,13 +110,13 @@ func resourceKubernetesReplicationControllerCreate(d *schema.ResourceData, meta
 	// but that means checking each pod status separately (which can be expensive at scale)
 	// as there's no aggregate data available from the API
 
-	log.Printf("[INFO] Submitted new replication controller: %#v", out)
+	log.Printf("[INFO] Submitted new replication controller: %s", redacted(out))
 
 	return resourceKubernetesReplicationControllerRead(d, meta)
 }
 
 func resourceKubernetesReplicationControllerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -125,12 +126,12 @@ func resourceKubernetesReplicationControllerRead(d *schema.ResourceData, meta in
 	log.Printf("[INFO] Reading replication controller %s", name)
 	rc, err := conn.CoreV1().ReplicationControllers(namespace).Get(name, metav1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received replication controller: %#v", rc)
+	log.Printf("[INFO] Received replication controller: %s", redacted(rc))
 
-	err = d.Set("metadata", flattenMetadata(rc.ObjectMeta))
+	err = d.Set("metadata", flattenMetadata(rc.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
@@ -149,17 +150,17 @@ func resourceKubernetesReplicationControllerRead(d *schema.ResourceData, meta in
 }
 
 func resourceKubernetesReplicationControllerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 
 	if d.HasChange("spec") {
-		spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
+		spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}), d)
 		if err != nil {
 			return err
 		}
@@ -169,16 +170,16 @@ func resourceKubernetesReplicationControllerUpdate(d *schema.ResourceData, meta
 			Value: spec,
 		})
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating replication controller %q: %v", name, string(data))
-	out, err := conn.CoreV1().ReplicationControllers(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating replication controller %q: %s", name, redacted(ops))
+	var out *api.ReplicationController
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.CoreV1().ReplicationControllers(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update replication controller: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated replication controller: %#v", out)
+	log.Printf("[INFO] Submitted updated replication controller: %s", redacted(out))
 
 	err = resource.Retry(d.Timeout(schema.TimeoutUpdate),
 		waitForDesiredReplicasFunc(conn, namespace, name))
@@ -190,38 +191,49 @@ func resourceKubernetesReplicationControllerUpdate(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesReplicationControllerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Deleting replication controller: %#v", name)
+	log.Printf("[INFO] Deleting replication controller: %q", name)
 
-	// Drain all replicas before deleting
-	var ops PatchOperations
-	ops = append(ops, &ReplaceOperation{
-		Path:  "/spec/replicas",
-		Value: 0,
-	})
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	_, err = conn.CoreV1().ReplicationControllers(namespace).Patch(name, pkgApi.JSONPatchType, data)
-	if err != nil {
-		return err
+	opts := expandDeleteOptions(d)
+	if opts.PropagationPolicy == nil || *opts.PropagationPolicy != metav1.DeletePropagationOrphan {
+		// Drain all replicas before deleting
+		var ops PatchOperations
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec/replicas",
+			Value: 0,
+		})
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		_, err = conn.CoreV1().ReplicationControllers(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		if err != nil {
+			return err
+		}
+
+		// Wait until all replicas are gone
+		err = resource.Retry(d.Timeout(schema.TimeoutDelete),
+			waitForDesiredReplicasFunc(conn, namespace, name))
+		if err != nil {
+			return err
+		}
 	}
 
-	// Wait until all replicas are gone
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete),
-		waitForDesiredReplicasFunc(conn, namespace, name))
+	err = conn.CoreV1().ReplicationControllers(namespace).Delete(name, opts)
 	if err != nil {
 		return err
 	}
 
-	err = conn.CoreV1().ReplicationControllers(namespace).Delete(name, &metav1.DeleteOptions{})
+	err = waitForDeletion("ReplicationController", name, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := conn.CoreV1().ReplicationControllers(namespace).Get(name, metav1.GetOptions{})
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -233,7 +245,7 @@ func resourceKubernetesReplicationControllerDelete(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesReplicationControllerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -246,7 +258,7 @@ func resourceKubernetesReplicationControllerExists(d *schema.ResourceData, meta
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
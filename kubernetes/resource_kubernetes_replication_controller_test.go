@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	api "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func TestAccKubernetesReplicationController_basic(t *testing.T) {
@@ -417,7 +416,7 @@ func TestAccKubernetesReplicationController_with_empty_dir_volume(t *testing.T)
 }
 
 func testAccCheckKubernetesReplicationControllerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*kubernetes.Clientset)
+	conn := testAccProvider.Meta().(*providerMeta).conn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "kubernetes_replication_controller" {
@@ -447,7 +446,7 @@ func testAccCheckKubernetesReplicationControllerExists(n string, obj *api.Replic
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*kubernetes.Clientset)
+		conn := testAccProvider.Meta().(*providerMeta).conn
 
 		namespace, name, err := idParts(rs.Primary.ID)
 		if err != nil {
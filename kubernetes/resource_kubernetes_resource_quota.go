@@ -11,7 +11,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesResourceQuota() *schema.Resource {
@@ -57,9 +56,9 @@ func resourceKubernetesResourceQuota() *schema.Resource {
 }
 
 func resourceKubernetesResourceQuotaCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	spec, err := expandResourceQuotaSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return err
@@ -68,12 +67,12 @@ func resourceKubernetesResourceQuotaCreate(d *schema.ResourceData, meta interfac
 		ObjectMeta: metadata,
 		Spec:       spec,
 	}
-	log.Printf("[INFO] Creating new resource quota: %#v", resQuota)
+	log.Printf("[INFO] Creating new resource quota: %s", redacted(resQuota))
 	out, err := conn.CoreV1().ResourceQuotas(metadata.Namespace).Create(&resQuota)
 	if err != nil {
 		return fmt.Errorf("Failed to create resource quota: %s", err)
 	}
-	log.Printf("[INFO] Submitted new resource quota: %#v", out)
+	log.Printf("[INFO] Submitted new resource quota: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
@@ -96,7 +95,7 @@ func resourceKubernetesResourceQuotaCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceKubernetesResourceQuotaRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -106,10 +105,10 @@ func resourceKubernetesResourceQuotaRead(d *schema.ResourceData, meta interface{
 	log.Printf("[INFO] Reading resource quota %s", name)
 	resQuota, err := conn.CoreV1().ResourceQuotas(namespace).Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received resource quota: %#v", resQuota)
+	log.Printf("[INFO] Received resource quota: %s", redacted(resQuota))
 
 	// This is to work around K8S bug
 	// See https://github.com/kubernetes/kubernetes/issues/44539
@@ -119,7 +118,7 @@ func resourceKubernetesResourceQuotaRead(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(resQuota.ObjectMeta))
+	err = d.Set("metadata", flattenMetadata(resQuota.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
@@ -132,14 +131,14 @@ func resourceKubernetesResourceQuotaRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceKubernetesResourceQuotaUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	var spec api.ResourceQuotaSpec
 	waitForChangedSpec := false
 	if d.HasChange("spec") {
@@ -154,16 +153,16 @@ func resourceKubernetesResourceQuotaUpdate(d *schema.ResourceData, meta interfac
 		})
 		waitForChangedSpec = true
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating resource quota %q: %v", name, string(data))
-	out, err := conn.CoreV1().ResourceQuotas(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating resource quota %q: %s", name, redacted(ops))
+	var out *api.ResourceQuota
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.CoreV1().ResourceQuotas(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update resource quota: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated resource quota: %#v", out)
+	log.Printf("[INFO] Submitted updated resource quota: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	if waitForChangedSpec {
@@ -188,14 +187,14 @@ func resourceKubernetesResourceQuotaUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceKubernetesResourceQuotaDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Deleting resource quota: %#v", name)
+	log.Printf("[INFO] Deleting resource quota: %q", name)
 	err = conn.CoreV1().ResourceQuotas(namespace).Delete(name, &meta_v1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -208,7 +207,7 @@ func resourceKubernetesResourceQuotaDelete(d *schema.ResourceData, meta interfac
 }
 
 func resourceKubernetesResourceQuotaExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -221,7 +220,7 @@ func resourceKubernetesResourceQuotaExists(d *schema.ResourceData, meta interfac
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
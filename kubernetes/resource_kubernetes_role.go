@@ -9,7 +9,6 @@ import (
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/api/rbac/v1"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesRole() *schema.Resource {
@@ -39,27 +38,27 @@ func resourceKubernetesRole() *schema.Resource {
 }
 
 func resourceKubernetesRoleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	binding := &v1.Role{
 		ObjectMeta: metadata,
 		Rules: expandRBACRules(d.Get("rule").([]interface{})),
 	}
-	log.Printf("[INFO] Creating new Role: %#v", binding)
+	log.Printf("[INFO] Creating new Role: %s", redacted(binding))
 	binding, err := conn.Rbac().Roles(metadata.Namespace).Create(binding)
 
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new Role: %#v", binding)
+	log.Printf("[INFO] Submitted new Role: %s", redacted(binding))
 	d.SetId(buildId(binding.ObjectMeta))
 
 	return resourceKubernetesRoleRead(d, meta)
 }
 
 func resourceKubernetesRoleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -69,18 +68,18 @@ func resourceKubernetesRoleRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Reading Role %s", name)
 	role, err := conn.Rbac().Roles(namespace).Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
 
-	log.Printf("[INFO] Received Role: %#v", role)
-	err = d.Set("metadata", flattenMetadata(role.ObjectMeta))
+	log.Printf("[INFO] Received Role: %s", redacted(role))
+	err = d.Set("metadata", flattenMetadata(role.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
 
 	flattenedRules := flattenRBACRules(role.Rules)
-	log.Printf("[DEBUG] Flattened Role ruleRef: %#v", flattenedRules)
+	log.Printf("[DEBUG] Flattened Role ruleRef: %s", redacted(flattenedRules))
 	err = d.Set("rule", flattenedRules)
 	if err != nil {
 		return err
@@ -90,42 +89,42 @@ func resourceKubernetesRoleRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceKubernetesRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("rule") {
 		diffOps := patchRbacRule(d)
 		ops = append(ops, diffOps...)
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating Role %q: %v", name, string(data))
-	out, err := conn.Rbac().Roles(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating Role %q: %s", name, redacted(ops))
+	var out *v1.Role
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.Rbac().Roles(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update Role: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated Role: %#v", out)
+	log.Printf("[INFO] Submitted updated Role: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesRoleRead(d, meta)
 }
 
 func resourceKubernetesRoleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Deleting Role: %#v", name)
+	log.Printf("[INFO] Deleting Role: %q", name)
 	err = conn.Rbac().Roles(namespace).Delete(name, &meta_v1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -137,7 +136,7 @@ func resourceKubernetesRoleDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceKubernetesRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -150,7 +149,7 @@ func resourceKubernetesRoleExists(d *schema.ResourceData, meta interface{}) (boo
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
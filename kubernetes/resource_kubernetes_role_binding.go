@@ -10,7 +10,6 @@ import (
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/api/rbac/v1"
 	//kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesRoleBinding() *schema.Resource {
@@ -49,28 +48,28 @@ func resourceKubernetesRoleBinding() *schema.Resource {
 }
 
 func resourceKubernetesRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	binding := &v1.RoleBinding{
 		ObjectMeta: metadata,
 		RoleRef:    expandRBACRoleRef(d.Get("role_ref").(interface{})),
 		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
 	}
-	log.Printf("[INFO] Creating new RoleBinding: %#v", binding)
+	log.Printf("[INFO] Creating new RoleBinding: %s", redacted(binding))
 	binding, err := conn.Rbac().RoleBindings(metadata.Namespace).Create(binding)
 
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new RoleBinding: %#v", binding)
+	log.Printf("[INFO] Submitted new RoleBinding: %s", redacted(binding))
 	d.SetId(buildId(binding.ObjectMeta))
 
 	return resourceKubernetesRoleBindingRead(d, meta)
 }
 
 func resourceKubernetesRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -80,25 +79,25 @@ func resourceKubernetesRoleBindingRead(d *schema.ResourceData, meta interface{})
 	log.Printf("[INFO] Reading RoleBinding %s", name)
 	binding, err := conn.Rbac().RoleBindings(namespace).Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
 
-	log.Printf("[INFO] Received RoleBinding: %#v", binding)
-	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta))
+	log.Printf("[INFO] Received RoleBinding: %s", redacted(binding))
+	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
 
 	flattenedRef := flattenRBACRoleRef(binding.RoleRef)
-	log.Printf("[DEBUG] Flattened RoleBinding roleRef: %#v", flattenedRef)
+	log.Printf("[DEBUG] Flattened RoleBinding roleRef: %s", redacted(flattenedRef))
 	err = d.Set("role_ref", flattenedRef)
 	if err != nil {
 		return err
 	}
 
 	flattenedSubjects := flattenRBACSubjects(binding.Subjects)
-	log.Printf("[DEBUG] Flattened RoleBinding subjects: %#v", flattenedSubjects)
+	log.Printf("[DEBUG] Flattened RoleBinding subjects: %s", redacted(flattenedSubjects))
 	err = d.Set("subject", flattenedSubjects)
 	if err != nil {
 		return err
@@ -108,42 +107,42 @@ func resourceKubernetesRoleBindingRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("subject") {
 		diffOps := patchRbacSubject(d)
 		ops = append(ops, diffOps...)
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating RoleBinding %q: %v", name, string(data))
-	out, err := conn.Rbac().RoleBindings(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating RoleBinding %q: %s", name, redacted(ops))
+	var out *v1.RoleBinding
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.Rbac().RoleBindings(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update RoleBinding: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated RoleBinding: %#v", out)
+	log.Printf("[INFO] Submitted updated RoleBinding: %s", redacted(out))
 	d.SetId(out.ObjectMeta.Name)
 
 	return resourceKubernetesRoleBindingRead(d, meta)
 }
 
 func resourceKubernetesRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Deleting RoleBinding: %#v", name)
+	log.Printf("[INFO] Deleting RoleBinding: %q", name)
 	err = conn.Rbac().RoleBindings(namespace).Delete(name, &meta_v1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -155,7 +154,7 @@ func resourceKubernetesRoleBindingDelete(d *schema.ResourceData, meta interface{
 }
 
 func resourceKubernetesRoleBindingExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -168,7 +167,7 @@ func resourceKubernetesRoleBindingExists(d *schema.ResourceData, meta interface{
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
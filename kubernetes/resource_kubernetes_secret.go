@@ -10,7 +10,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesSecret() *schema.Resource {
@@ -44,9 +43,9 @@ func resourceKubernetesSecret() *schema.Resource {
 }
 
 func resourceKubernetesSecretCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	secret := api.Secret{
 		ObjectMeta: metadata,
 		Data:       expandStringMapToByteMap(d.Get("data").(map[string]interface{})),
@@ -56,20 +55,20 @@ func resourceKubernetesSecretCreate(d *schema.ResourceData, meta interface{}) er
 		secret.Type = api.SecretType(v.(string))
 	}
 
-	log.Printf("[INFO] Creating new secret: %#v", secret)
+	log.Printf("[INFO] Creating new secret: %s", redacted(secret))
 	out, err := conn.CoreV1().Secrets(metadata.Namespace).Create(&secret)
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Submitting new secret: %#v", out)
+	log.Printf("[INFO] Submitting new secret: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesSecretRead(d, meta)
 }
 
 func resourceKubernetesSecretRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -82,8 +81,8 @@ func resourceKubernetesSecretRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	log.Printf("[INFO] Received secret: %#v", secret)
-	err = d.Set("metadata", flattenMetadata(secret.ObjectMeta))
+	log.Printf("[INFO] Received secret: %s", redacted(secret))
+	err = d.Set("metadata", flattenMetadata(secret.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
@@ -95,14 +94,14 @@ func resourceKubernetesSecretRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceKubernetesSecretUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("data") {
 		oldV, newV := d.GetChange("data")
 
@@ -114,25 +113,24 @@ func resourceKubernetesSecretUpdate(d *schema.ResourceData, meta interface{}) er
 		ops = append(ops, diffOps...)
 	}
 
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-
-	log.Printf("[INFO] Updating secret %q: %v", name, data)
-	out, err := conn.CoreV1().Secrets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating secret %q: %s", name, redactedSecret(ops))
+	var out *api.Secret
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.CoreV1().Secrets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update secret: %s", err)
 	}
 
-	log.Printf("[INFO] Submitting updated secret: %#v", out)
+	log.Printf("[INFO] Submitting updated secret: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesSecretRead(d, meta)
 }
 
 func resourceKubernetesSecretDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -153,7 +151,7 @@ func resourceKubernetesSecretDelete(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceKubernetesSecretExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -166,7 +164,7 @@ func resourceKubernetesSecretExists(d *schema.ResourceData, meta interface{}) (b
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 
 	return true, err
@@ -25,6 +25,11 @@ func resourceKubernetesService() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("service", true),
 			"spec": {
@@ -53,6 +58,20 @@ func resourceKubernetesService() *schema.Resource {
 							Description: "The external reference that kubedns or equivalent will return as a CNAME record for this service. No proxying will be involved. Must be a valid DNS name and requires `type` to be `ExternalName`.",
 							Optional:    true,
 						},
+						"external_traffic_policy": {
+							Type:         schema.TypeString,
+							Description:  "Denotes if this service desires to route external traffic to node-local or cluster-wide endpoints. `Local` preserves the client source IP and avoids a second hop for `LoadBalancer` and `NodePort` type services, but risks potentially imbalanced traffic spreading. `Cluster` obscures the client source IP and may cause a second hop to another node, but should have good overall load-spreading. Defaults to `Cluster` for `LoadBalancer` and `NodePort` type services. More info: https://kubernetes.io/docs/tutorials/services/source-ip/",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateAttributeValueIsIn([]string{"Cluster", "Local"}),
+						},
+						"health_check_node_port": {
+							Type:         schema.TypeInt,
+							Description:  "The node port serving the health check of the service. Only applies to `type = LoadBalancer` with `external_traffic_policy = Local`. Allocated by the system if not specified.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validatePortNum,
+						},
 						"load_balancer_ip": {
 							Type:        schema.TypeString,
 							Description: "Only applies to `type = LoadBalancer`. LoadBalancer will get created with the IP specified in this field. This feature depends on whether the underlying cloud-provider supports specifying this field when a load balancer is created. This field will be ignored if the cloud-provider does not support the feature.",
@@ -103,6 +122,12 @@ func resourceKubernetesService() *schema.Resource {
 								},
 							},
 						},
+						"publish_not_ready_addresses": {
+							Type:        schema.TypeBool,
+							Description: "When set to true, DNS implementations must publish the addresses of the pods targeted by the service even if the pods aren't ready, e.g. for the peer discovery of stateful sets. Defaults to false.",
+							Optional:    true,
+							Default:     false,
+						},
 						"selector": {
 							Type:        schema.TypeMap,
 							Description: "Route service traffic to pods with label keys and values matching this selector. Only applies to types `ClusterIP`, `NodePort`, and `LoadBalancer`. More info: http://kubernetes.io/docs/user-guide/services#overview",
@@ -114,6 +139,34 @@ func resourceKubernetesService() *schema.Resource {
 							Optional:    true,
 							Default:     "None",
 						},
+						"session_affinity_config": {
+							Type:        schema.TypeList,
+							Description: "The configuration of the session affinity. Defaulted by the system when `session_affinity = ClientIP`.",
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_ip": {
+										Type:        schema.TypeList,
+										Description: "The configuration of the session affinity based on the client IP.",
+										Optional:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"timeout_seconds": {
+													Type:         schema.TypeInt,
+													Description:  "The number of seconds a session sticks to the same pod, at most 86400 (one day). Defaults to 10800 (three hours).",
+													Optional:     true,
+													Default:      10800,
+													ValidateFunc: validateSessionAffinityTimeout,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"type": {
 							Type:        schema.TypeString,
 							Description: "Determines how the service is exposed. Defaults to `ClusterIP`. Valid options are `ExternalName`, `ClusterIP`, `NodePort`, and `LoadBalancer`. `ExternalName` maps to the specified `external_name`. More info: http://kubernetes.io/docs/user-guide/services#overview",
@@ -139,52 +192,43 @@ func resourceKubernetesService() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_load_balancer": {
+				Type:        schema.TypeBool,
+				Description: "Wait for a service of type `LoadBalancer` to be assigned an IP address or hostname. Defaults to true.",
+				Optional:    true,
+				Default:     true,
+			},
+			"wait_for_rollout": {
+				Type:        schema.TypeBool,
+				Description: "Wait for a service of type `LoadBalancer` to be assigned an IP address or hostname. Defaults to true.",
+				Optional:    true,
+				Default:     true,
+				Deprecated:  "Use wait_for_load_balancer instead",
+			},
 		},
 	}
 }
 
 func resourceKubernetesServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	svc := api.Service{
 		ObjectMeta: metadata,
 		Spec:       expandServiceSpec(d.Get("spec").([]interface{})),
 	}
-	log.Printf("[INFO] Creating new service: %#v", svc)
+	log.Printf("[INFO] Creating new service: %s", redacted(svc))
 	out, err := conn.CoreV1().Services(metadata.Namespace).Create(&svc)
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new service: %#v", out)
+	log.Printf("[INFO] Submitted new service: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
-	if out.Spec.Type == api.ServiceTypeLoadBalancer {
-		log.Printf("[DEBUG] Waiting for load balancer to assign IP/hostname")
-
-		err = resource.Retry(10*time.Minute, func() *resource.RetryError {
-			svc, err := conn.CoreV1().Services(out.Namespace).Get(out.Name, meta_v1.GetOptions{})
-			if err != nil {
-				log.Printf("[DEBUG] Received error: %#v", err)
-				return resource.NonRetryableError(err)
-			}
-
-			lbIngress := svc.Status.LoadBalancer.Ingress
-
-			log.Printf("[INFO] Received service status: %#v", svc.Status)
-			if len(lbIngress) > 0 {
-				return nil
-			}
-
-			return resource.RetryableError(fmt.Errorf(
-				"Waiting for service %q to assign IP/hostname for a load balancer", d.Id()))
-		})
+	if out.Spec.Type == api.ServiceTypeLoadBalancer && serviceWaitForLoadBalancer(d) {
+		err = waitForServiceLoadBalancer(conn, out.ObjectMeta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			lastWarnings, wErr := getLastWarningsForObject(conn, out.ObjectMeta, "Service", 3)
-			if wErr != nil {
-				return wErr
-			}
-			return fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+			return err
 		}
 	}
 
@@ -192,7 +236,7 @@ func resourceKubernetesServiceCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesServiceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -202,11 +246,11 @@ func resourceKubernetesServiceRead(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[INFO] Reading service %s", name)
 	svc, err := conn.CoreV1().Services(namespace).Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received service: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta))
+	log.Printf("[INFO] Received service: %s", redacted(svc))
+	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
@@ -217,7 +261,7 @@ func resourceKubernetesServiceRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	flattened := flattenServiceSpec(svc.Spec)
-	log.Printf("[DEBUG] Flattened service spec: %#v", flattened)
+	log.Printf("[DEBUG] Flattened service spec: %s", redacted(flattened))
 	err = d.Set("spec", flattened)
 	if err != nil {
 		return err
@@ -227,14 +271,14 @@ func resourceKubernetesServiceRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceKubernetesServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("spec") {
 		serverVersion, err := conn.ServerVersion()
 		if err != nil {
@@ -246,30 +290,37 @@ func resourceKubernetesServiceUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 		ops = append(ops, diffOps...)
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating service %q: %v", name, string(data))
-	out, err := conn.CoreV1().Services(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating service %q: %s", name, redacted(ops))
+	var out *api.Service
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.CoreV1().Services(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update service: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated service: %#v", out)
+	log.Printf("[INFO] Submitted updated service: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
+	if out.Spec.Type == api.ServiceTypeLoadBalancer && serviceWaitForLoadBalancer(d) {
+		err = waitForServiceLoadBalancer(conn, out.ObjectMeta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceKubernetesServiceRead(d, meta)
 }
 
 func resourceKubernetesServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Deleting service: %#v", name)
+	log.Printf("[INFO] Deleting service: %q", name)
 	err = conn.CoreV1().Services(namespace).Delete(name, &meta_v1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -282,7 +333,7 @@ func resourceKubernetesServiceDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesServiceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -295,7 +346,46 @@ func resourceKubernetesServiceExists(d *schema.ResourceData, meta interface{}) (
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
+
+// serviceWaitForLoadBalancer tells whether to wait for the load balancer,
+// disabling either wait_for_load_balancer or the deprecated
+// wait_for_rollout turns waiting off
+func serviceWaitForLoadBalancer(d *schema.ResourceData) bool {
+	return d.Get("wait_for_load_balancer").(bool) && d.Get("wait_for_rollout").(bool)
+}
+
+// waitForServiceLoadBalancer waits until the cloud provider has assigned
+// an IP address or hostname to the load balancer of the given service
+func waitForServiceLoadBalancer(conn *kubernetes.Clientset, metadata meta_v1.ObjectMeta, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for load balancer to assign IP/hostname")
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		svc, err := conn.CoreV1().Services(metadata.Namespace).Get(metadata.Name, meta_v1.GetOptions{})
+		if err != nil {
+			log.Printf("[DEBUG] Received error: %s", redacted(err))
+			return resource.NonRetryableError(err)
+		}
+
+		lbIngress := svc.Status.LoadBalancer.Ingress
+
+		log.Printf("[INFO] Received service status: %s", redacted(svc.Status))
+		if len(lbIngress) > 0 {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf(
+			"Waiting for service %q to assign IP/hostname for a load balancer", buildId(metadata)))
+	})
+	if err != nil {
+		lastWarnings, wErr := getLastWarningsForObject(conn, metadata, "Service", 3)
+		if wErr != nil {
+			return wErr
+		}
+		return fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+	}
+	return nil
+}
@@ -11,7 +11,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesServiceAccount() *schema.Resource {
@@ -65,21 +64,21 @@ func resourceKubernetesServiceAccount() *schema.Resource {
 }
 
 func resourceKubernetesServiceAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	svcAcc := api.ServiceAccount{
 		AutomountServiceAccountToken: ptrToBool(false),
 		ObjectMeta:                   metadata,
 		ImagePullSecrets:             expandLocalObjectReferenceArray(d.Get("image_pull_secret").(*schema.Set).List()),
 		Secrets:                      expandServiceAccountSecrets(d.Get("secret").(*schema.Set).List(), ""),
 	}
-	log.Printf("[INFO] Creating new service account: %#v", svcAcc)
+	log.Printf("[INFO] Creating new service account: %s", redacted(svcAcc))
 	out, err := conn.CoreV1().ServiceAccounts(metadata.Namespace).Create(&svcAcc)
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new service account: %#v", out)
+	log.Printf("[INFO] Submitted new service account: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	// Here we get the only chance to identify and store default secret name
@@ -126,7 +125,7 @@ func diffObjectReferences(origOrs []api.ObjectReference, ors []api.ObjectReferen
 }
 
 func resourceKubernetesServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -136,11 +135,11 @@ func resourceKubernetesServiceAccountRead(d *schema.ResourceData, meta interface
 	log.Printf("[INFO] Reading service account %s", name)
 	svcAcc, err := conn.CoreV1().ServiceAccounts(namespace).Get(name, metav1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received service account: %#v", svcAcc)
-	err = d.Set("metadata", flattenMetadata(svcAcc.ObjectMeta))
+	log.Printf("[INFO] Received service account: %s", redacted(svcAcc))
+	err = d.Set("metadata", flattenMetadata(svcAcc.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
@@ -149,21 +148,21 @@ func resourceKubernetesServiceAccountRead(d *schema.ResourceData, meta interface
 	defaultSecretName := d.Get("default_secret_name").(string)
 	log.Printf("[DEBUG] Default secret name is %q", defaultSecretName)
 	secrets := flattenServiceAccountSecrets(svcAcc.Secrets, defaultSecretName)
-	log.Printf("[DEBUG] Flattened secrets: %#v", secrets)
+	log.Printf("[DEBUG] Flattened secrets: %s", redacted(secrets))
 	d.Set("secret", secrets)
 
 	return nil
 }
 
 func resourceKubernetesServiceAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
 	if d.HasChange("image_pull_secret") {
 		v := d.Get("image_pull_secret").(*schema.Set).List()
 		ops = append(ops, &ReplaceOperation{
@@ -180,30 +179,30 @@ func resourceKubernetesServiceAccountUpdate(d *schema.ResourceData, meta interfa
 			Value: expandServiceAccountSecrets(v, defaultSecretName),
 		})
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating service account %q: %v", name, string(data))
-	out, err := conn.CoreV1().ServiceAccounts(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	log.Printf("[INFO] Updating service account %q: %s", name, redacted(ops))
+	var out *api.ServiceAccount
+	err = sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.CoreV1().ServiceAccounts(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update service account: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated service account: %#v", out)
+	log.Printf("[INFO] Submitted updated service account: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesServiceAccountRead(d, meta)
 }
 
 func resourceKubernetesServiceAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Deleting service account: %#v", name)
+	log.Printf("[INFO] Deleting service account: %q", name)
 	err = conn.CoreV1().ServiceAccounts(namespace).Delete(name, &metav1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -216,7 +215,7 @@ func resourceKubernetesServiceAccountDelete(d *schema.ResourceData, meta interfa
 }
 
 func resourceKubernetesServiceAccountExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -229,7 +228,7 @@ func resourceKubernetesServiceAccountExists(d *schema.ResourceData, meta interfa
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
@@ -12,7 +12,6 @@ import (
 	api "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func TestAccKubernetesService_basic(t *testing.T) {
@@ -165,6 +164,32 @@ func TestAccKubernetesService_loadBalancer(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesService_withoutWaitForLoadBalancer(t *testing.T) {
+	var conf api.Service
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_service.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Clusters without a cloud provider never assign an address,
+				// the apply would time out if it waited for one
+				Config: testAccKubernetesServiceConfig_withoutWaitForLoadBalancer(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists("kubernetes_service.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "wait_for_load_balancer", "false"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.type", "LoadBalancer"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.external_traffic_policy", "Local"),
+					resource.TestCheckResourceAttrSet("kubernetes_service.test", "spec.0.health_check_node_port"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesService_nodePort(t *testing.T) {
 	var conf api.Service
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -201,6 +226,11 @@ func TestAccKubernetesService_nodePort(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.selector.%", "1"),
 					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.selector.App", "MyApp"),
 					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.session_affinity", "ClientIP"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.session_affinity_config.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.session_affinity_config.0.client_ip.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.session_affinity_config.0.client_ip.0.timeout_seconds", "300"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.external_traffic_policy", "Local"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.publish_not_ready_addresses", "true"),
 					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.type", "NodePort"),
 					testAccCheckServicePorts(&conf, []api.ServicePort{
 						{
@@ -218,6 +248,17 @@ func TestAccKubernetesService_nodePort(t *testing.T) {
 					}),
 				),
 			},
+			{
+				Config: testAccKubernetesServiceConfig_nodePort_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists("kubernetes_service.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.session_affinity", "None"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.session_affinity_config.#", "0"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.external_traffic_policy", "Cluster"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.publish_not_ready_addresses", "false"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.type", "NodePort"),
+				),
+			},
 		},
 	})
 }
@@ -350,7 +391,7 @@ func TestAccKubernetesService_importBasic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_load_balancer", "wait_for_rollout"},
 			},
 		},
 	})
@@ -403,7 +444,7 @@ func TestAccKubernetesService_importGeneratedName(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_load_balancer", "wait_for_rollout"},
 			},
 		},
 	})
@@ -432,7 +473,7 @@ func testAccCheckServicePorts(svc *api.Service, expected []api.ServicePort) reso
 }
 
 func testAccCheckKubernetesServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*kubernetes.Clientset)
+	conn := testAccProvider.Meta().(*providerMeta).conn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "kubernetes_service" {
@@ -462,7 +503,7 @@ func testAccCheckKubernetesServiceExists(n string, obj *api.Service) resource.Te
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*kubernetes.Clientset)
+		conn := testAccProvider.Meta().(*providerMeta).conn
 
 		namespace, name, err := idParts(rs.Primary.ID)
 		if err != nil {
@@ -573,6 +614,27 @@ resource "kubernetes_service" "test" {
 }`, name, name)
 }
 
+func testAccKubernetesServiceConfig_withoutWaitForLoadBalancer(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_service" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		selector {
+			App = "MyApp"
+		}
+		external_traffic_policy = "Local"
+		port {
+			port = 8888
+			target_port = 80
+		}
+		type = "LoadBalancer"
+	}
+	wait_for_load_balancer = false
+}`, name)
+}
+
 func testAccKubernetesServiceConfig_nodePort(name string) string {
 	return fmt.Sprintf(`
 resource "kubernetes_service" "test" {
@@ -587,6 +649,43 @@ resource "kubernetes_service" "test" {
 			App = "MyApp"
 		}
 		session_affinity = "ClientIP"
+		session_affinity_config {
+			client_ip {
+				timeout_seconds = 300
+			}
+		}
+		external_traffic_policy = "Local"
+		publish_not_ready_addresses = true
+		port {
+			name = "first"
+			port = 10222
+			target_port = 22
+		}
+		port {
+			name = "second"
+			port = 10333
+			target_port = 33
+		}
+		type = "NodePort"
+	}
+}`, name, name)
+}
+
+func testAccKubernetesServiceConfig_nodePort_modified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_service" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		external_name = "ext-name-%s"
+		external_ips = ["10.0.0.4", "10.0.0.5"]
+		load_balancer_ip = "12.0.0.125"
+		selector {
+			App = "MyApp"
+		}
+		session_affinity = "None"
+		external_traffic_policy = "Cluster"
 		port {
 			name = "first"
 			port = 10222
@@ -4,15 +4,12 @@ import (
 	"fmt"
 	"log"
 	"time"
-	"encoding/json"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/hashicorp/terraform/helper/resource"
+	v1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	v1 "k8s.io/api/apps/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesStatefulSet() *schema.Resource {
@@ -26,75 +23,63 @@ func resourceKubernetesStatefulSet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("statefulset", false),
+			"metadata":                  namespacedMetadataSchema("statefulset", false),
+			"delete_propagation_policy": deletePropagationPolicySchema("stateful set"),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec of the pod owned by the cluster",
 				Required:    true,
-				ForceNew: true,
 				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: statefulsetSpecFields(),
 				},
 			},
+			"wait_for_rollout": {
+				Type:        schema.TypeBool,
+				Description: "Wait for the rollout of the statefulset to complete. Defaults to true.",
+				Optional:    true,
+				Default:     true,
+			},
 		},
 	}
 }
 
 func resourceKubernetesStatefulsetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	statefulset := &v1.StatefulSet{
 		ObjectMeta: metadata,
-		Spec: expandStatefulsetSpec(d.Get("spec").([]interface{})),
+		Spec:       expandStatefulsetSpec(d.Get("spec").([]interface{}), d, meta.(*providerMeta).metadata),
 	}
-	log.Printf("[INFO] Creating new Statefulset: %#v", statefulset)
+	log.Printf("[INFO] Creating new Statefulset: %s", redacted(statefulset))
 	statefulset, err := conn.AppsV1().StatefulSets(metadata.Namespace).Create(statefulset)
 
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new Statefulset: %#v", statefulset)
+	log.Printf("[INFO] Submitted new Statefulset: %s", redacted(statefulset))
 	d.SetId(buildId(statefulset.ObjectMeta))
-	name := statefulset.ObjectMeta.Name
-
-	pending := make([]string, *statefulset.Spec.Replicas)
-	for i := range pending {
-		pending[i] = fmt.Sprintf("%v", i)
-	}
-
-	stateConf := &resource.StateChangeConf{
-		Target:  []string{fmt.Sprintf("%v", *statefulset.Spec.Replicas)},
-		Pending: pending,
-		Timeout: 20 * time.Minute,
-		Refresh: func() (interface{}, string, error) {
-			out, err := conn.AppsV1().StatefulSets(metadata.Namespace).Get(name, meta_v1.GetOptions{})
-			if err != nil {
-				log.Printf("[ERROR] Received error: %#v", err)
-				return out, "", err
-			}
-
-			statusPhase := fmt.Sprintf("%v", out.Status.ReadyReplicas)
-			log.Printf("[DEBUG] Statefulset %s ready replicas: %#v", out.Name, statusPhase)
-			return out, statusPhase, nil
-		},
-	}
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		lastWarnings, wErr := getLastWarningsForObject(conn, statefulset.ObjectMeta, "StatefulSet", 3)
-		if wErr != nil {
-			return wErr
+
+	if d.Get("wait_for_rollout").(bool) {
+		err = waitForRollout(conn, "StatefulSet", statefulset.ObjectMeta, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
 		}
-		return fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
 	}
 
 	return resourceKubernetesStatefulsetRead(d, meta)
 }
 
 func resourceKubernetesStatefulsetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -104,18 +89,18 @@ func resourceKubernetesStatefulsetRead(d *schema.ResourceData, meta interface{})
 	log.Printf("[INFO] Reading Statefulset %s", name)
 	statefulset, err := conn.AppsV1().StatefulSets(namespace).Get(name, meta_v1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
 
-	log.Printf("[INFO] Received Statefulset: %#v", statefulset)
-	err = d.Set("metadata", flattenMetadata(statefulset.ObjectMeta))
+	log.Printf("[INFO] Received Statefulset: %s", redacted(statefulset))
+	err = d.Set("metadata", flattenMetadata(statefulset.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
 
-	flattenedSpec := flattenStatefulsetSpec(statefulset.Spec)
-	log.Printf("[DEBUG] Flattened Statefulset spec: %#v", flattenedSpec)
+	flattenedSpec := flattenStatefulsetSpec(statefulset.Spec, d, meta.(*providerMeta).metadata)
+	log.Printf("[DEBUG] Flattened Statefulset spec: %s", redacted(flattenedSpec))
 	err = d.Set("spec", flattenedSpec)
 	if err != nil {
 		return err
@@ -125,42 +110,73 @@ func resourceKubernetesStatefulsetRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesStatefulsetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
-	
-		namespace, name, err := idParts(d.Id())
-		if err != nil {
-			return err
-		}
-	
-		metadata := expandMetadata(d.Get("metadata").([]interface{}))
-		statefulset := &v1.StatefulSet{
-			ObjectMeta: metadata,
-			Spec: expandStatefulsetSpec(d.Get("spec").([]interface{})),
-		}
+	conn := meta.(*providerMeta).conn
+	config := meta.(*providerMeta).metadata
 
-		data, err := json.Marshal(statefulset)
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// The metadata is patched key by key, leaving the ignored keys alone
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	if len(ops) > 0 {
+		log.Printf("[INFO] Updating metadata of statefulset %s: %s", d.Id(), redacted(ops))
+		err = sendJSONPatch(ops, func(data []byte) (err error) {
+			_, err = conn.AppsV1().StatefulSets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+			return err
+		})
 		if err != nil {
-			return fmt.Errorf("Failed to marshal update operations: %s", err)
+			return fmt.Errorf("Failed to update statefulset metadata: %s", err)
 		}
-	
-		log.Printf("[INFO] Updating statefulset %s: %s", d.Id(), statefulset)
-	
-		out, err := conn.AppsV1().StatefulSets(namespace).Patch(name, pkgApi.StrategicMergePatchType, data)
+	}
+
+	live, err := conn.AppsV1().StatefulSets(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	spec := expandStatefulsetSpec(d.Get("spec").([]interface{}), d, config)
+	keepIgnoredMetadata(&spec.Template.ObjectMeta, live.Spec.Template.ObjectMeta, config)
+
+	// The template is replaced as a whole so that removed fields (e.g. env vars) don't linger
+	data, err := strategicMergePatchReplacing(map[string]interface{}{"spec": spec}, "spec.template")
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating statefulset %s: %s", d.Id(), redactedJSON(data))
+
+	out, err := conn.AppsV1().StatefulSets(namespace).Patch(name, pkgApi.StrategicMergePatchType, data)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted updated statefulset: %s", redacted(out))
+	d.SetId(buildId(out.ObjectMeta))
+
+	if d.Get("wait_for_rollout").(bool) {
+		err = waitForRollout(conn, "StatefulSet", out.ObjectMeta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
-		log.Printf("[INFO] Submitted updated statefulset: %#v", out)
-	
-		d.SetId(buildId(out.ObjectMeta))
-		return resourceKubernetesStatefulsetRead(d, meta)
+	}
+
+	return resourceKubernetesStatefulsetRead(d, meta)
 }
 
 func resourceKubernetesStatefulsetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
-	log.Printf("[INFO] Deleting Statefulset: %#v", name)
-	err = conn.AppsV1().StatefulSets(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	log.Printf("[INFO] Deleting Statefulset: %q", name)
+	err = conn.AppsV1().StatefulSets(namespace).Delete(name, expandDeleteOptions(d))
+	if err != nil {
+		return err
+	}
+
+	err = waitForDeletion("StatefulSet", name, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := conn.AppsV1().StatefulSets(namespace).Get(name, meta_v1.GetOptions{})
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -171,7 +187,7 @@ func resourceKubernetesStatefulsetDelete(d *schema.ResourceData, meta interface{
 }
 
 func resourceKubernetesStatefulsetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	namespace, name, err := idParts(d.Id())
 	log.Printf("[INFO] Checking Statefulset %s", name)
@@ -180,7 +196,7 @@ func resourceKubernetesStatefulsetExists(d *schema.ResourceData, meta interface{
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
@@ -9,7 +9,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesStorageClass() *schema.Resource {
@@ -42,9 +41,9 @@ func resourceKubernetesStorageClass() *schema.Resource {
 }
 
 func resourceKubernetesStorageClassCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
 	storageClass := api.StorageClass{
 		ObjectMeta:  metadata,
 		Provisioner: d.Get("storage_provisioner").(string),
@@ -54,29 +53,29 @@ func resourceKubernetesStorageClassCreate(d *schema.ResourceData, meta interface
 		storageClass.Parameters = expandStringMap(v.(map[string]interface{}))
 	}
 
-	log.Printf("[INFO] Creating new storage class: %#v", storageClass)
+	log.Printf("[INFO] Creating new storage class: %s", redacted(storageClass))
 	out, err := conn.StorageV1().StorageClasses().Create(&storageClass)
 	if err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new storage class: %#v", out)
+	log.Printf("[INFO] Submitted new storage class: %s", redacted(out))
 	d.SetId(out.Name)
 
 	return resourceKubernetesStorageClassRead(d, meta)
 }
 
 func resourceKubernetesStorageClassRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Reading storage class %s", name)
 	storageClass, err := conn.StorageV1().StorageClasses().Get(name, metav1.GetOptions{})
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 		return err
 	}
-	log.Printf("[INFO] Received storage class: %#v", storageClass)
-	err = d.Set("metadata", flattenMetadata(storageClass.ObjectMeta))
+	log.Printf("[INFO] Received storage class: %s", redacted(storageClass))
+	err = d.Set("metadata", flattenMetadata(storageClass.ObjectMeta, d, meta.(*providerMeta).metadata))
 	if err != nil {
 		return err
 	}
@@ -87,30 +86,30 @@ func resourceKubernetesStorageClassRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceKubernetesStorageClassUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating storage class %q: %v", name, string(data))
-	out, err := conn.StorageV1().StorageClasses().Patch(name, pkgApi.JSONPatchType, data)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	log.Printf("[INFO] Updating storage class %q: %s", name, redacted(ops))
+	var out *api.StorageClass
+	err := sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.StorageV1().StorageClasses().Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update storage class: %s", err)
 	}
-	log.Printf("[INFO] Submitted updated storage class: %#v", out)
+	log.Printf("[INFO] Submitted updated storage class: %s", redacted(out))
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesStorageClassRead(d, meta)
 }
 
 func resourceKubernetesStorageClassDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
-	log.Printf("[INFO] Deleting storage class: %#v", name)
+	log.Printf("[INFO] Deleting storage class: %q", name)
 	err := conn.StorageV1().StorageClasses().Delete(name, &metav1.DeleteOptions{})
 	if err != nil {
 		return err
@@ -123,7 +122,7 @@ func resourceKubernetesStorageClassDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceKubernetesStorageClassExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*providerMeta).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Checking storage class %s", name)
@@ -132,7 +131,7 @@ func resourceKubernetesStorageClassExists(d *schema.ResourceData, meta interface
 		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
 			return false, nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
 	}
 	return true, err
 }
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	api "k8s.io/api/storage/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func TestAccKubernetesStorageClass_basic(t *testing.T) {
@@ -184,7 +183,7 @@ func testAccCheckStorageClassParameters(m *api.StorageClass, expected map[string
 }
 
 func testAccCheckKubernetesStorageClassDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*kubernetes.Clientset)
+	conn := testAccProvider.Meta().(*providerMeta).conn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "kubernetes_storage_class" {
@@ -209,7 +208,7 @@ func testAccCheckKubernetesStorageClassExists(n string, obj *api.StorageClass) r
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*kubernetes.Clientset)
+		conn := testAccProvider.Meta().(*providerMeta).conn
 		name := rs.Primary.ID
 		out, err := conn.StorageV1().StorageClasses().Get(name, meta_v1.GetOptions{})
 		if err != nil {
@@ -0,0 +1,131 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesValidatingWebhookConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesValidatingWebhookConfigurationCreate,
+		Read:   resourceKubernetesValidatingWebhookConfigurationRead,
+		Exists: resourceKubernetesValidatingWebhookConfigurationExists,
+		Update: resourceKubernetesValidatingWebhookConfigurationUpdate,
+		Delete: resourceKubernetesValidatingWebhookConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("validating webhook configuration", true),
+			"webhook":  webhookConfigurationSchema("validating"),
+		},
+	}
+}
+
+func resourceKubernetesValidatingWebhookConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), meta.(*providerMeta).metadata)
+	cfg := api.ValidatingWebhookConfiguration{
+		ObjectMeta: metadata,
+		Webhooks:   expandWebhooks(d.Get("webhook").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new validating webhook configuration: %s", redacted(cfg))
+	out, err := conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Create(&cfg)
+	if err != nil {
+		return fmt.Errorf("Failed to create validating webhook configuration: %s", err)
+	}
+	log.Printf("[INFO] Submitted new validating webhook configuration: %s", redacted(out))
+	d.SetId(out.Name)
+
+	return resourceKubernetesValidatingWebhookConfigurationRead(d, meta)
+}
+
+func resourceKubernetesValidatingWebhookConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Reading validating webhook configuration %s", name)
+	cfg, err := conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+		return err
+	}
+	log.Printf("[INFO] Received validating webhook configuration: %s", redacted(cfg))
+	err = d.Set("metadata", flattenMetadata(cfg.ObjectMeta, d, meta.(*providerMeta).metadata))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("webhook", flattenWebhooks(cfg.Webhooks))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesValidatingWebhookConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d, meta)
+	if d.HasChange("webhook") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/webhooks",
+			Value: expandWebhooks(d.Get("webhook").([]interface{})),
+		})
+	}
+	log.Printf("[INFO] Updating validating webhook configuration %q: %s", name, redacted(ops))
+	var out *api.ValidatingWebhookConfiguration
+	err := sendJSONPatch(ops, func(data []byte) (err error) {
+		out, err = conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update validating webhook configuration: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated validating webhook configuration: %s", redacted(out))
+	d.SetId(out.Name)
+
+	return resourceKubernetesValidatingWebhookConfigurationRead(d, meta)
+}
+
+func resourceKubernetesValidatingWebhookConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting validating webhook configuration: %q", name)
+	err := conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Delete(name, &meta_v1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Validating webhook configuration %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesValidatingWebhookConfigurationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*providerMeta).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Checking validating webhook configuration %s", name)
+	_, err := conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %s", redacted(err))
+	}
+	return true, err
+}
@@ -0,0 +1,196 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	api "k8s.io/api/admissionregistration/v1beta1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesValidatingWebhookConfiguration_basic(t *testing.T) {
+	var conf api.ValidatingWebhookConfiguration
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_validating_webhook_configuration.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesValidatingWebhookConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesValidatingWebhookConfigurationConfig_service(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesValidatingWebhookConfigurationExists("kubernetes_validating_webhook_configuration.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_validating_webhook_configuration.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_validating_webhook_configuration.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.name", "configmaps.tf-acc-test.example.com"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.failure_policy", "Ignore"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.client_config.0.service.0.name", "tf-acc-test-webhook"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.client_config.0.service.0.namespace", "default"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.client_config.0.service.0.path", "/validate"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.client_config.0.ca_bundle", "-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----\n"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.rule.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.rule.0.operations.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.namespace_selector.0.match_labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.namespace_selector.0.match_labels.tf-acc-test", name),
+				),
+			},
+			{
+				Config: testAccKubernetesValidatingWebhookConfigurationConfig_url(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesValidatingWebhookConfigurationExists("kubernetes_validating_webhook_configuration.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.client_config.0.service.#", "0"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.client_config.0.url", "https://tf-acc-test.example.com/validate"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.0.rule.0.operations.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.1.name", "secrets.tf-acc-test.example.com"),
+					resource.TestCheckResourceAttr("kubernetes_validating_webhook_configuration.test", "webhook.1.namespace_selector.0.match_expressions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesValidatingWebhookConfiguration_importBasic(t *testing.T) {
+	resourceName := "kubernetes_validating_webhook_configuration.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesValidatingWebhookConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesValidatingWebhookConfigurationConfig_service(name),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesValidatingWebhookConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*providerMeta).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_validating_webhook_configuration" {
+			continue
+		}
+		name := rs.Primary.ID
+		resp, err := conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Validating webhook configuration still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesValidatingWebhookConfigurationExists(n string, obj *api.ValidatingWebhookConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*providerMeta).conn
+		name := rs.Primary.ID
+		out, err := conn.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+// The webhooks only match namespaces labelled with the name of the test
+// and ignore failures, so they don't interfere with other tests
+func testAccKubernetesValidatingWebhookConfigurationConfig_service(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_validating_webhook_configuration" "test" {
+  metadata {
+    name = "%s"
+  }
+  webhook {
+    name = "configmaps.tf-acc-test.example.com"
+    client_config {
+      service {
+        name = "tf-acc-test-webhook"
+        path = "/validate"
+      }
+      ca_bundle = "-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----\n"
+    }
+    rule {
+      api_groups   = [""]
+      api_versions = ["v1"]
+      operations   = ["CREATE", "UPDATE"]
+      resources    = ["configmaps"]
+    }
+    namespace_selector {
+      match_labels {
+        tf-acc-test = "%s"
+      }
+    }
+  }
+}
+`, name, name)
+}
+
+func testAccKubernetesValidatingWebhookConfigurationConfig_url(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_validating_webhook_configuration" "test" {
+  metadata {
+    name = "%s"
+  }
+  webhook {
+    name = "configmaps.tf-acc-test.example.com"
+    client_config {
+      url = "https://tf-acc-test.example.com/validate"
+    }
+    rule {
+      api_groups   = [""]
+      api_versions = ["v1"]
+      operations   = ["CREATE"]
+      resources    = ["configmaps"]
+    }
+    namespace_selector {
+      match_labels {
+        tf-acc-test = "%s"
+      }
+    }
+  }
+  webhook {
+    name = "secrets.tf-acc-test.example.com"
+    client_config {
+      url = "https://tf-acc-test.example.com/validate"
+    }
+    rule {
+      api_groups   = [""]
+      api_versions = ["v1"]
+      operations   = ["*"]
+      resources    = ["secrets"]
+    }
+    namespace_selector {
+      match_expressions {
+        key      = "tf-acc-test"
+        operator = "In"
+        values   = ["%s"]
+      }
+    }
+  }
+}
+`, name, name, name)
+}
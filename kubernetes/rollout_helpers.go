@@ -0,0 +1,135 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	api "k8s.io/api/apps/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetes "k8s.io/client-go/kubernetes"
+)
+
+const (
+	rolloutInProgress = "InProgress"
+	rolloutComplete   = "Complete"
+)
+
+// waitForRollout waits until the controller of the given kind (Deployment,
+// StatefulSet or DaemonSet) has observed the latest spec and finished
+// rolling it out to all of its pods
+func waitForRollout(conn *kubernetes.Clientset, kind string, metadata meta_v1.ObjectMeta, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Target:  []string{rolloutComplete},
+		Pending: []string{rolloutInProgress},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			out, state, err := rolloutState(conn, kind, metadata)
+			if err != nil {
+				log.Printf("[ERROR] Received error: %s", redacted(err))
+				return out, "Error", err
+			}
+			log.Printf("[DEBUG] %s %s rollout state: %s", kind, metadata.Name, state)
+			return out, state, nil
+		},
+	}
+	_, err := stateConf.WaitForState()
+	if err == nil {
+		return nil
+	}
+
+	lastWarnings, wErr := getLastWarningsForObject(conn, metadata, kind, 3)
+	if wErr != nil {
+		return wErr
+	}
+	return fmt.Errorf("%s rollout failed: %s%s", kind, err, stringifyEvents(lastWarnings))
+}
+
+func rolloutState(conn *kubernetes.Clientset, kind string, metadata meta_v1.ObjectMeta) (interface{}, string, error) {
+	switch kind {
+	case "Deployment":
+		out, err := conn.AppsV1().Deployments(metadata.Namespace).Get(metadata.Name, meta_v1.GetOptions{})
+		if err != nil {
+			return out, "", err
+		}
+		state, err := deploymentRolloutState(out)
+		return out, state, err
+	case "StatefulSet":
+		out, err := conn.AppsV1().StatefulSets(metadata.Namespace).Get(metadata.Name, meta_v1.GetOptions{})
+		if err != nil {
+			return out, "", err
+		}
+		return out, statefulSetRolloutState(out), nil
+	case "DaemonSet":
+		out, err := conn.AppsV1().DaemonSets(metadata.Namespace).Get(metadata.Name, meta_v1.GetOptions{})
+		if err != nil {
+			return out, "", err
+		}
+		return out, daemonSetRolloutState(out), nil
+	}
+	return nil, "", fmt.Errorf("Waiting for the rollout of %s isn't supported", kind)
+}
+
+func deploymentRolloutState(d *api.Deployment) (string, error) {
+	if d.Generation > d.Status.ObservedGeneration {
+		return rolloutInProgress, nil
+	}
+	for _, c := range d.Status.Conditions {
+		if c.Type == api.DeploymentProgressing && c.Reason == "ProgressDeadlineExceeded" {
+			return "", fmt.Errorf("Deployment %q exceeded its progress deadline: %s", d.Name, c.Message)
+		}
+	}
+	if d.Spec.Replicas != nil && d.Status.UpdatedReplicas < *d.Spec.Replicas {
+		return rolloutInProgress, nil
+	}
+	if d.Status.Replicas > d.Status.UpdatedReplicas {
+		// Old replicas are pending termination
+		return rolloutInProgress, nil
+	}
+	if d.Status.AvailableReplicas < d.Status.UpdatedReplicas {
+		return rolloutInProgress, nil
+	}
+	return rolloutComplete, nil
+}
+
+func statefulSetRolloutState(s *api.StatefulSet) string {
+	if s.Generation > s.Status.ObservedGeneration {
+		return rolloutInProgress
+	}
+	if s.Spec.Replicas != nil && s.Status.ReadyReplicas < *s.Spec.Replicas {
+		return rolloutInProgress
+	}
+	if s.Spec.UpdateStrategy.Type != api.RollingUpdateStatefulSetStrategyType {
+		// Pods are only replaced once they're deleted by hand
+		return rolloutComplete
+	}
+	if r := s.Spec.UpdateStrategy.RollingUpdate; r != nil && r.Partition != nil && s.Spec.Replicas != nil {
+		// Only the pods with an ordinal above the partition are updated
+		if s.Status.UpdatedReplicas < *s.Spec.Replicas-*r.Partition {
+			return rolloutInProgress
+		}
+		return rolloutComplete
+	}
+	if s.Status.UpdateRevision != s.Status.CurrentRevision {
+		return rolloutInProgress
+	}
+	return rolloutComplete
+}
+
+func daemonSetRolloutState(d *api.DaemonSet) string {
+	if d.Generation > d.Status.ObservedGeneration {
+		return rolloutInProgress
+	}
+	if d.Spec.UpdateStrategy.Type != api.RollingUpdateDaemonSetStrategyType {
+		// Pods are only replaced once they're deleted by hand
+		return rolloutComplete
+	}
+	if d.Status.UpdatedNumberScheduled < d.Status.DesiredNumberScheduled {
+		return rolloutInProgress
+	}
+	if d.Status.NumberAvailable < d.Status.DesiredNumberScheduled {
+		return rolloutInProgress
+	}
+	return rolloutComplete
+}
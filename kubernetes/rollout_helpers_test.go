@@ -0,0 +1,190 @@
+package kubernetes
+
+import (
+	"testing"
+
+	api "k8s.io/api/apps/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeploymentRolloutState(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Generation    int64
+		Status        api.DeploymentStatus
+		ExpectedState string
+		ExpectedError bool
+	}{
+		{
+			"spec not observed yet",
+			2,
+			api.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			rolloutInProgress,
+			false,
+		},
+		{
+			"replicas being updated",
+			2,
+			api.DeploymentStatus{ObservedGeneration: 2, Replicas: 4, UpdatedReplicas: 1, AvailableReplicas: 3},
+			rolloutInProgress,
+			false,
+		},
+		{
+			"old replicas pending termination",
+			2,
+			api.DeploymentStatus{ObservedGeneration: 2, Replicas: 4, UpdatedReplicas: 3, AvailableReplicas: 3},
+			rolloutInProgress,
+			false,
+		},
+		{
+			"updated replicas not available yet",
+			2,
+			api.DeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 2},
+			rolloutInProgress,
+			false,
+		},
+		{
+			"progress deadline exceeded",
+			2,
+			api.DeploymentStatus{
+				ObservedGeneration: 2, Replicas: 4, UpdatedReplicas: 1, AvailableReplicas: 3,
+				Conditions: []api.DeploymentCondition{
+					{Type: api.DeploymentProgressing, Reason: "ProgressDeadlineExceeded"},
+				},
+			},
+			"",
+			true,
+		},
+		{
+			"complete",
+			2,
+			api.DeploymentStatus{
+				ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3,
+				Conditions: []api.DeploymentCondition{
+					{Type: api.DeploymentProgressing, Reason: "NewReplicaSetAvailable"},
+				},
+			},
+			rolloutComplete,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		d := &api.Deployment{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "test", Generation: tc.Generation},
+			Spec:       api.DeploymentSpec{Replicas: ptrToInt32(3)},
+			Status:     tc.Status,
+		}
+		state, err := deploymentRolloutState(d)
+		if tc.ExpectedError != (err != nil) {
+			t.Fatalf("%s: unexpected error: %v", tc.Name, err)
+		}
+		if state != tc.ExpectedState {
+			t.Fatalf("%s: expected state %q, given %q", tc.Name, tc.ExpectedState, state)
+		}
+	}
+}
+
+func TestStatefulSetRolloutState(t *testing.T) {
+	partition := int32(2)
+
+	cases := []struct {
+		Name          string
+		Strategy      api.StatefulSetUpdateStrategy
+		Status        api.StatefulSetStatus
+		ExpectedState string
+	}{
+		{
+			"spec not observed yet",
+			api.StatefulSetUpdateStrategy{Type: api.RollingUpdateStatefulSetStrategyType},
+			api.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3},
+			rolloutInProgress,
+		},
+		{
+			"replicas not ready",
+			api.StatefulSetUpdateStrategy{Type: api.RollingUpdateStatefulSetStrategyType},
+			api.StatefulSetStatus{ObservedGeneration: 2, ReadyReplicas: 2, CurrentRevision: "a", UpdateRevision: "a"},
+			rolloutInProgress,
+		},
+		{
+			"revisions differ",
+			api.StatefulSetUpdateStrategy{Type: api.RollingUpdateStatefulSetStrategyType},
+			api.StatefulSetStatus{ObservedGeneration: 2, ReadyReplicas: 3, CurrentRevision: "a", UpdateRevision: "b"},
+			rolloutInProgress,
+		},
+		{
+			"partitioned rollout",
+			api.StatefulSetUpdateStrategy{
+				Type:          api.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &api.RollingUpdateStatefulSetStrategy{Partition: &partition},
+			},
+			api.StatefulSetStatus{ObservedGeneration: 2, ReadyReplicas: 3, UpdatedReplicas: 1, CurrentRevision: "a", UpdateRevision: "b"},
+			rolloutComplete,
+		},
+		{
+			"on delete",
+			api.StatefulSetUpdateStrategy{Type: api.OnDeleteStatefulSetStrategyType},
+			api.StatefulSetStatus{ObservedGeneration: 2, ReadyReplicas: 3, CurrentRevision: "a", UpdateRevision: "b"},
+			rolloutComplete,
+		},
+		{
+			"complete",
+			api.StatefulSetUpdateStrategy{Type: api.RollingUpdateStatefulSetStrategyType},
+			api.StatefulSetStatus{ObservedGeneration: 2, ReadyReplicas: 3, UpdatedReplicas: 3, CurrentRevision: "b", UpdateRevision: "b"},
+			rolloutComplete,
+		},
+	}
+
+	for _, tc := range cases {
+		s := &api.StatefulSet{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "test", Generation: 2},
+			Spec:       api.StatefulSetSpec{Replicas: ptrToInt32(3), UpdateStrategy: tc.Strategy},
+			Status:     tc.Status,
+		}
+		if state := statefulSetRolloutState(s); state != tc.ExpectedState {
+			t.Fatalf("%s: expected state %q, given %q", tc.Name, tc.ExpectedState, state)
+		}
+	}
+}
+
+func TestDaemonSetRolloutState(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Status        api.DaemonSetStatus
+		ExpectedState string
+	}{
+		{
+			"spec not observed yet",
+			api.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 2, UpdatedNumberScheduled: 2, NumberAvailable: 2},
+			rolloutInProgress,
+		},
+		{
+			"pods being updated",
+			api.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 2, UpdatedNumberScheduled: 1, NumberAvailable: 2},
+			rolloutInProgress,
+		},
+		{
+			"updated pods not available yet",
+			api.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 2, UpdatedNumberScheduled: 2, NumberAvailable: 1},
+			rolloutInProgress,
+		},
+		{
+			"complete",
+			api.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 2, UpdatedNumberScheduled: 2, NumberAvailable: 2},
+			rolloutComplete,
+		},
+	}
+
+	for _, tc := range cases {
+		d := &api.DaemonSet{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "test", Generation: 2},
+			Spec: api.DaemonSetSpec{
+				UpdateStrategy: api.DaemonSetUpdateStrategy{Type: api.RollingUpdateDaemonSetStrategyType},
+			},
+			Status: tc.Status,
+		}
+		if state := daemonSetRolloutState(d); state != tc.ExpectedState {
+			t.Fatalf("%s: expected state %q, given %q", tc.Name, tc.ExpectedState, state)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func affinityFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"node_affinity": {
+			Type:        schema.TypeList,
+			Description: "Node affinity scheduling rules for the pod.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: nodeAffinityFields(),
+			},
+		},
+		"pod_affinity": {
+			Type:        schema.TypeList,
+			Description: "Inter-pod topological affinity. rules that specify that certain pods should be placed in the same topological domain (e.g. same node, same rack, same zone, same power domain, etc.)",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: podAffinityFields(),
+			},
+		},
+		"pod_anti_affinity": {
+			Type:        schema.TypeList,
+			Description: "Inter-pod topological affinity. rules that specify that certain pods should be placed in different topological domains (e.g. not on the same node, rack, zone, power domain, etc.)",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: podAffinityFields(),
+			},
+		},
+	}
+}
+
+func nodeAffinityFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"required_during_scheduling_ignored_during_execution": {
+			Type:        schema.TypeList,
+			Description: "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a node label update), the system may or may not try to eventually evict the pod from its node.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"node_selector_term": {
+						Type:        schema.TypeList,
+						Description: "List of node selector terms. The terms are ORed.",
+						Required:    true,
+						Elem: &schema.Resource{
+							Schema: nodeSelectorTermFields(),
+						},
+					},
+				},
+			},
+		},
+		"preferred_during_scheduling_ignored_during_execution": {
+			Type:        schema.TypeList,
+			Description: "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"weight": {
+						Type:         schema.TypeInt,
+						Description:  "Weight associated with matching the corresponding node selector term, in the range 1-100.",
+						Required:     true,
+						ValidateFunc: validateAffinityWeight,
+					},
+					"preference": {
+						Type:        schema.TypeList,
+						Description: "A node selector term, associated with the corresponding weight.",
+						Required:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: nodeSelectorTermFields(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func nodeSelectorTermFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"match_expressions": {
+			Type:        schema.TypeList,
+			Description: "A list of node selector requirements by node's labels. The requirements are ANDed.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:        schema.TypeString,
+						Description: "The label key that the selector applies to.",
+						Required:    true,
+					},
+					"operator": {
+						Type:         schema.TypeString,
+						Description:  "A key's relationship to a set of values. Valid operators are `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.",
+						Required:     true,
+						ValidateFunc: validateAttributeValueIsIn([]string{"In", "NotIn", "Exists", "DoesNotExist", "Gt", "Lt"}),
+					},
+					"values": {
+						Type:        schema.TypeSet,
+						Description: "An array of string values. If the operator is `In` or `NotIn`, the values array must be non-empty. If the operator is `Exists` or `DoesNotExist`, the values array must be empty. If the operator is `Gt` or `Lt`, the values array must have a single element, which will be interpreted as an integer.",
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Set:         schema.HashString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func podAffinityFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"required_during_scheduling_ignored_during_execution": {
+			Type:        schema.TypeList,
+			Description: "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. When there are multiple elements, the lists of nodes corresponding to each term are intersected, i.e. all terms must be satisfied.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: podAffinityTermFields(),
+			},
+		},
+		"preferred_during_scheduling_ignored_during_execution": {
+			Type:        schema.TypeList,
+			Description: "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node(s) with the highest sum of weights are the most preferred.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"weight": {
+						Type:         schema.TypeInt,
+						Description:  "Weight associated with matching the corresponding pod affinity term, in the range 1-100.",
+						Required:     true,
+						ValidateFunc: validateAffinityWeight,
+					},
+					"pod_affinity_term": {
+						Type:        schema.TypeList,
+						Description: "A pod affinity term, associated with the corresponding weight.",
+						Required:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: podAffinityTermFields(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func podAffinityTermFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"label_selector": {
+			Type:        schema.TypeList,
+			Description: "A label query over a set of pods.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(true),
+			},
+		},
+		"namespaces": {
+			Type:        schema.TypeSet,
+			Description: "Namespaces the label selector applies to. Defaults to the namespace of the pod.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+		},
+		"topology_key": {
+			Type:        schema.TypeString,
+			Description: "The key of the node labels whose values define the topological domain, e.g. `kubernetes.io/hostname` or `failure-domain.beta.kubernetes.io/zone`. Empty topology key is not allowed.",
+			Required:    true,
+		},
+	}
+}
@@ -196,6 +196,42 @@ func volumeMountFields() map[string]*schema.Schema {
 			Optional:    true,
 			Description: `Path within the volume from which the container's volume should be mounted. Defaults to "" (volume's root).`,
 		},
+		"mount_propagation": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Determines how mounts are propagated from the host to container and the other way around. One of `None`, `HostToContainer` or `Bidirectional`. When not set, `None` is used.",
+			ValidateFunc: validateAttributeValueIsIn([]string{"None", "HostToContainer", "Bidirectional"}),
+		},
+	}
+}
+
+func volumeDeviceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"device_path": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Path inside of the container that the device will be mapped to.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Must match the name of a persistent_volume_claim in the pod.",
+		},
+	}
+}
+
+func envFromSourceRefFields(kind string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+		},
+		"optional": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Specify whether the " + kind + " must be defined",
+		},
 	}
 }
 
@@ -262,7 +298,7 @@ func containerFields(isUpdatable, isInitContainer bool) map[string]*schema.Schem
 									Type:        schema.TypeList,
 									Optional:    true,
 									MaxItems:    1,
-									Description: "Selects a field of the pod: supports metadata.name, metadata.namespace, metadata.labels, metadata.annotations, spec.nodeName, spec.serviceAccountName, status.podIP..",
+									Description: "Selects a field of the pod: supports metadata.name, metadata.namespace, metadata.labels, metadata.annotations, spec.nodeName, spec.serviceAccountName, status.hostIP and status.podIP.",
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											"api_version": {
@@ -283,7 +319,7 @@ func containerFields(isUpdatable, isInitContainer bool) map[string]*schema.Schem
 									Type:        schema.TypeList,
 									Optional:    true,
 									MaxItems:    1,
-									Description: "Selects a field of the pod: supports metadata.name, metadata.namespace, metadata.labels, metadata.annotations, spec.nodeName, spec.serviceAccountName, status.podIP..",
+									Description: "Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.",
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											"container_name": {
@@ -302,7 +338,7 @@ func containerFields(isUpdatable, isInitContainer bool) map[string]*schema.Schem
 									Type:        schema.TypeList,
 									Optional:    true,
 									MaxItems:    1,
-									Description: "Selects a field of the pod: supports metadata.name, metadata.namespace, metadata.labels, metadata.annotations, spec.nodeName, spec.serviceAccountName, status.podIP..",
+									Description: "Selects a key of a secret in the pod's namespace.",
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											"key": {
@@ -324,6 +360,38 @@ func containerFields(isUpdatable, isInitContainer bool) map[string]*schema.Schem
 				},
 			},
 		},
+		"env_from": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "List of sources to populate environment variables in the container. The keys defined within a source must be a C_IDENTIFIER. All invalid keys will be reported as an event when the container is starting. When a key exists in multiple sources, the value associated with the last source will take precedence. Values defined by an `env` with a duplicate key will take precedence.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"config_map_ref": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "The ConfigMap to select from",
+						Elem: &schema.Resource{
+							Schema: envFromSourceRefFields("ConfigMap"),
+						},
+					},
+					"prefix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "An optional identifer to prepend to each key in the ConfigMap or Secret. Must be a C_IDENTIFIER.",
+					},
+					"secret_ref": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "The Secret to select from",
+						Elem: &schema.Resource{
+							Schema: envFromSourceRefFields("Secret"),
+						},
+					},
+				},
+			},
+		},
 		"image": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -339,7 +407,6 @@ func containerFields(isUpdatable, isInitContainer bool) map[string]*schema.Schem
 			Type:        schema.TypeList,
 			Optional:    true,
 			MaxItems:    1,
-			ForceNew:    true,
 			Description: "Actions that the management system should take in response to container lifecycle events",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
@@ -366,7 +433,6 @@ func containerFields(isUpdatable, isInitContainer bool) map[string]*schema.Schem
 			Type:        schema.TypeList,
 			Optional:    true,
 			MaxItems:    1,
-			ForceNew:    true,
 			Description: "Periodic probe of container liveness. Container will be restarted if the probe fails. Cannot be updated. More info: http://kubernetes.io/docs/user-guide/pod-states#container-probes",
 			Elem:        probeSchema(),
 		},
@@ -416,7 +482,6 @@ func containerFields(isUpdatable, isInitContainer bool) map[string]*schema.Schem
 			Type:        schema.TypeList,
 			Optional:    true,
 			MaxItems:    1,
-			ForceNew:    true,
 			Description: "Periodic probe of container service readiness. Container will be removed from service endpoints if the probe fails. Cannot be updated. More info: http://kubernetes.io/docs/user-guide/pod-states#container-probes",
 			Elem:        probeSchema(),
 		},
@@ -435,7 +500,6 @@ func containerFields(isUpdatable, isInitContainer bool) map[string]*schema.Schem
 			Type:        schema.TypeList,
 			Optional:    true,
 			MaxItems:    1,
-			ForceNew:    true,
 			Description: "Security options the pod should run with. More info: http://releases.k8s.io/HEAD/docs/design/security_context.md",
 			Elem:        securityContextSchema(),
 		},
@@ -454,16 +518,30 @@ func containerFields(isUpdatable, isInitContainer bool) map[string]*schema.Schem
 		"termination_message_path": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			ForceNew:    true,
 			Default:     "/dev/termination-log",
 			Description: "Optional: Path at which the file to which the container's termination message will be written is mounted into the container's filesystem. Message written is intended to be brief final status, such as an assertion failure message. Defaults to /dev/termination-log. Cannot be updated.",
 		},
+		"termination_message_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Indicate how the termination message should be populated. `File` will use the contents of termination_message_path to populate the container status message on both success and failure. `FallbackToLogsOnError` will use the last chunk of container log output if the termination message file is empty and the container exited with an error. Defaults to `File`. Cannot be updated.",
+			ValidateFunc: validateAttributeValueIsIn([]string{"File", "FallbackToLogsOnError"}),
+		},
 		"tty": {
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Default:     false,
 			Description: "Whether this container should allocate a TTY for itself",
 		},
+		"volume_device": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Raw block devices to be used by the container. This is an alpha feature of Kubernetes 1.9 and later.",
+			Elem: &schema.Resource{
+				Schema: volumeDeviceFields(),
+			},
+		},
 		"volume_mount": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -475,7 +553,6 @@ func containerFields(isUpdatable, isInitContainer bool) map[string]*schema.Schem
 		"working_dir": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			ForceNew:    true,
 			Description: "Container's working directory. If not specified, the container runtime's default will be used, which might be configured in the container image. Cannot be updated.",
 		},
 	}
@@ -537,6 +614,12 @@ func probeSchema() *schema.Resource {
 
 func securityContextSchema() *schema.Resource {
 	m := map[string]*schema.Schema{
+		"allow_privilege_escalation": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Controls whether a process can gain more privileges than its parent process. This directly controls if the no_new_privs flag will be set on the container process. Defaults to true, unless a pod security policy sets another default.",
+		},
 		"privileged": {
 			Type:        schema.TypeBool,
 			Optional:    true,
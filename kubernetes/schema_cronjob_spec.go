@@ -93,7 +93,7 @@ func jobSpecFields() map[string]*schema.Schema {
 			Optional: true,
 			MaxItems: 1,
 			Elem: &schema.Resource{
-				Schema: labelSelectorFields(),
+				Schema: labelSelectorFields(false),
 			},
 		},
 		"template": {
@@ -0,0 +1,132 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func customResourceDefinitionSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"group": {
+			Type:        schema.TypeString,
+			Description: "The API group of the custom resources, served under `/apis/<group>/<version>`. Must match the name of the definition, which is `<plural>.<group>`.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Description: "The names used to serve the custom resources.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"categories": {
+						Type:        schema.TypeSet,
+						Description: "The grouped resources the custom resource belongs to, e.g. `all`.",
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Set:         schema.HashString,
+					},
+					"kind": {
+						Type:        schema.TypeString,
+						Description: "The serialized kind of the resource, normally CamelCase and singular.",
+						Required:    true,
+						ForceNew:    true,
+					},
+					"list_kind": {
+						Type:        schema.TypeString,
+						Description: "The serialized kind of the list of the resource. Defaults to `<kind>List`.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"plural": {
+						Type:        schema.TypeString,
+						Description: "The plural name of the resource, served under `/apis/<group>/<version>/.../<plural>`. Must be all lowercase.",
+						Required:    true,
+						ForceNew:    true,
+					},
+					"short_names": {
+						Type:        schema.TypeSet,
+						Description: "Short names for the resource, e.g. `kubectl get <short name>`. Must be all lowercase.",
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Set:         schema.HashString,
+					},
+					"singular": {
+						Type:        schema.TypeString,
+						Description: "The singular name of the resource. Must be all lowercase. Defaults to the lowercased `kind`.",
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"scope": {
+			Type:         schema.TypeString,
+			Description:  "Whether the custom resources are cluster scoped (`Cluster`) or namespace scoped (`Namespaced`). Defaults to `Namespaced`.",
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "Namespaced",
+			ValidateFunc: validateAttributeValueIsIn([]string{"Cluster", "Namespaced"}),
+		},
+		"subresources": {
+			Type:        schema.TypeList,
+			Description: "The subresources served for the custom resources. Requires the `CustomResourceSubresources` feature gate.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"scale": {
+						Type:        schema.TypeList,
+						Description: "Serves the `/scale` subresource.",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"label_selector_path": {
+									Type:        schema.TypeString,
+									Description: "The JSON path inside of a custom resource that corresponds to the label selector of the scale subresource, e.g. `.status.labelSelector`.",
+									Optional:    true,
+								},
+								"spec_replicas_path": {
+									Type:        schema.TypeString,
+									Description: "The JSON path inside of a custom resource that corresponds to the desired replicas of the scale subresource, e.g. `.spec.replicas`.",
+									Required:    true,
+								},
+								"status_replicas_path": {
+									Type:        schema.TypeString,
+									Description: "The JSON path inside of a custom resource that corresponds to the actual replicas of the scale subresource, e.g. `.status.replicas`.",
+									Required:    true,
+								},
+							},
+						},
+					},
+					"status": {
+						Type:        schema.TypeBool,
+						Description: "Serves the `/status` subresource, the main endpoint then ignores changes to the status.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"validation": {
+			Type:             schema.TypeString,
+			Description:      "The OpenAPI v3 schema used to validate the custom resources, in YAML or JSON format.",
+			Optional:         true,
+			DiffSuppressFunc: suppressEquivalentManifests,
+			ValidateFunc: func(value interface{}, key string) (ws []string, es []error) {
+				if _, err := parseManifest(value.(string)); err != nil {
+					es = append(es, fmt.Errorf("%s: %s", key, err))
+				}
+				return
+			},
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Description: "The API version of the custom resources, served under `/apis/<group>/<version>`.",
+			Required:    true,
+			ForceNew:    true,
+		},
+	}
+}
@@ -1,5 +1,5 @@
-
 package kubernetes
+
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -9,9 +9,10 @@ func daemonsetSpecFields() map[string]*schema.Schema {
 		"selector": {
 			Type:     schema.TypeList,
 			Required: true,
+			ForceNew: true,
 			MaxItems: 1,
 			Elem: &schema.Resource{
-				Schema: labelSelectorFields(),
+				Schema: labelSelectorFields(false),
 			},
 		},
 		"template": {
@@ -27,8 +28,8 @@ func daemonsetSpecFields() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 			Description: "",
 			Optional:    true,
-			Computed: true,
-			MaxItems: 1,
+			Computed:    true,
+			MaxItems:    1,
 			Elem: &schema.Resource{
 				Schema: statefulsetUpdateSpecFields(),
 			},
@@ -44,4 +45,4 @@ func daemonsetSpecFields() map[string]*schema.Schema {
 			Computed: true,
 		},
 	}
-}
\ No newline at end of file
+}
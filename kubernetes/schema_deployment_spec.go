@@ -1,5 +1,5 @@
-
 package kubernetes
+
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -15,9 +15,10 @@ func deploymentSpecFields() map[string]*schema.Schema {
 		"selector": {
 			Type:     schema.TypeList,
 			Required: true,
+			ForceNew: true,
 			MaxItems: 1,
 			Elem: &schema.Resource{
-				Schema: labelSelectorFields(),
+				Schema: labelSelectorFields(false),
 			},
 		},
 		"template": {
@@ -33,8 +34,8 @@ func deploymentSpecFields() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 			Description: "",
 			Optional:    true,
-			Computed: true,
-			MaxItems: 1,
+			Computed:    true,
+			MaxItems:    1,
 			Elem: &schema.Resource{
 				Schema: deploymentStrategyFields(),
 			},
@@ -42,17 +43,17 @@ func deploymentSpecFields() map[string]*schema.Schema {
 		"min_ready_seconds": {
 			Type:     schema.TypeInt,
 			Optional: true,
-			Default: 0,
+			Default:  0,
 		},
 		"revision_history_limit": {
 			Type:     schema.TypeInt,
 			Optional: true,
-			Default: 10,
+			Default:  10,
 		},
 		"progress_deadline_seconds": {
 			Type:     schema.TypeInt,
 			Optional: true,
-			Default: 600,
+			Default:  600,
 		},
 	}
 }
@@ -68,7 +69,7 @@ func deploymentStrategyFields() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 			Description: "",
 			Optional:    true,
-			Computed: true,
+			Computed:    true,
 			MaxItems:    1,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
@@ -86,4 +87,4 @@ func deploymentStrategyFields() map[string]*schema.Schema {
 			},
 		},
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,34 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// computedSchema returns a copy of the given resource attribute where the
+// attribute and all its nested attributes are computed, so data sources
+// can reuse the schemas (and flatteners) of the matching resources
+func computedSchema(in *schema.Schema) *schema.Schema {
+	out := &schema.Schema{
+		Type:        in.Type,
+		Description: in.Description,
+		Computed:    true,
+		Sensitive:   in.Sensitive,
+		MaxItems:    in.MaxItems,
+		Set:         in.Set,
+		Elem:        in.Elem,
+	}
+	if r, ok := in.Elem.(*schema.Resource); ok {
+		out.Elem = &schema.Resource{
+			Schema: computedFields(r.Schema),
+		}
+	}
+	return out
+}
+
+func computedFields(in map[string]*schema.Schema) map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema, len(in))
+	for k, v := range in {
+		out[k] = computedSchema(v)
+	}
+	return out
+}
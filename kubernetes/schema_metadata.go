@@ -83,10 +83,10 @@ func namespacedMetadataSchema(objectName string, generatableName bool) *schema.S
 	fields := metadataFields(objectName)
 	fields["namespace"] = &schema.Schema{
 		Type:        schema.TypeString,
-		Description: fmt.Sprintf("Namespace defines the space within which name of the %s must be unique.", objectName),
+		Description: fmt.Sprintf("Namespace defines the space within which name of the %s must be unique. Defaults to the `default_namespace` of the provider or `default`.", objectName),
 		Optional:    true,
+		Computed:    true,
 		ForceNew:    true,
-		Default:     "default",
 	}
 	if generatableName {
 		fields["generate_name"] = &schema.Schema{
@@ -0,0 +1,206 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func podSecurityPolicySpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"allow_privilege_escalation": {
+			Type:        schema.TypeBool,
+			Description: "Determines if a pod can request to allow privilege escalation. Defaults to true.",
+			Optional:    true,
+			Default:     true,
+		},
+		"allowed_capabilities": {
+			Type:        schema.TypeSet,
+			Description: "A list of capabilities that can be requested to add to the container, in addition to the `default_add_capabilities`. `*` allows all capabilities.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+		},
+		"allowed_flex_volumes": {
+			Type:        schema.TypeList,
+			Description: "A whitelist of Flexvolume drivers. Empty or nil indicates that all Flexvolume drivers may be used. This parameter is effective only when `volumes` contains `flexVolume`.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"driver": {
+						Type:        schema.TypeString,
+						Description: "The name of the Flexvolume driver.",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"allowed_host_paths": {
+			Type:        schema.TypeList,
+			Description: "A white list of allowed host paths. Empty indicates that all host paths may be used.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"path_prefix": {
+						Type:        schema.TypeString,
+						Description: "The path prefix that the host volume must match, e.g. `/foo` allows `/foo`, `/foo/` and `/foo/bar` but not `/food` or `/etc/foo`.",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"default_add_capabilities": {
+			Type:        schema.TypeSet,
+			Description: "The default set of capabilities that will be added to the container unless the pod spec specifically drops the capability.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+		},
+		"default_allow_privilege_escalation": {
+			Type:        schema.TypeBool,
+			Description: "Controls the default setting for whether a process can gain more privileges than its parent process. Defaults to false.",
+			Optional:    true,
+			Default:     false,
+		},
+		"fs_group": {
+			Type:        schema.TypeList,
+			Description: "The strategy that will dictate what fs group is used by the security context.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: idRangeStrategyFields([]string{"MustRunAs", "RunAsAny"}),
+			},
+		},
+		"host_ipc": {
+			Type:        schema.TypeBool,
+			Description: "Determines if the policy allows the use of host IPC in the pod spec.",
+			Optional:    true,
+		},
+		"host_network": {
+			Type:        schema.TypeBool,
+			Description: "Determines if the policy allows the use of host networking in the pod spec.",
+			Optional:    true,
+		},
+		"host_pid": {
+			Type:        schema.TypeBool,
+			Description: "Determines if the policy allows the use of host PID in the pod spec.",
+			Optional:    true,
+		},
+		"host_ports": {
+			Type:        schema.TypeList,
+			Description: "Determines which host port ranges are allowed to be exposed.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"max": {
+						Type:         schema.TypeInt,
+						Description:  "The end of the range, inclusive.",
+						Required:     true,
+						ValidateFunc: validatePortNum,
+					},
+					"min": {
+						Type:         schema.TypeInt,
+						Description:  "The start of the range, inclusive.",
+						Required:     true,
+						ValidateFunc: validatePortNum,
+					},
+				},
+			},
+		},
+		"privileged": {
+			Type:        schema.TypeBool,
+			Description: "Determines if a pod can request to be run as privileged.",
+			Optional:    true,
+		},
+		"read_only_root_filesystem": {
+			Type:        schema.TypeBool,
+			Description: "When set to true will force containers to run with a read only root file system. If the container specifically requests to run with a non-read only root file system the policy will deny the pod.",
+			Optional:    true,
+		},
+		"required_drop_capabilities": {
+			Type:        schema.TypeSet,
+			Description: "The capabilities that will be dropped from the container. These are required to be dropped and cannot be added.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+		},
+		"run_as_user": {
+			Type:        schema.TypeList,
+			Description: "The strategy that will dictate the allowable RunAsUser values that may be set.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: idRangeStrategyFields([]string{"MustRunAs", "MustRunAsNonRoot", "RunAsAny"}),
+			},
+		},
+		"se_linux": {
+			Type:        schema.TypeList,
+			Description: "The strategy that will dictate the allowable labels that may be set.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rule": {
+						Type:         schema.TypeString,
+						Description:  "The strategy that will dictate the allowable labels that may be set: `MustRunAs` or `RunAsAny`.",
+						Required:     true,
+						ValidateFunc: validateAttributeValueIsIn([]string{"MustRunAs", "RunAsAny"}),
+					},
+					"se_linux_options": {
+						Type:        schema.TypeList,
+						Description: "The SELinux context to be applied to the containers, required by `MustRunAs`.",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: seLinuxOptionsField(),
+						},
+					},
+				},
+			},
+		},
+		"supplemental_groups": {
+			Type:        schema.TypeList,
+			Description: "The strategy that will dictate what supplemental groups are used by the security context.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: idRangeStrategyFields([]string{"MustRunAs", "RunAsAny"}),
+			},
+		},
+		"volumes": {
+			Type:        schema.TypeSet,
+			Description: "A white list of allowed volume plugins, e.g. `configMap`, `secret` or `persistentVolumeClaim`. `*` allows all volume plugins.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+		},
+	}
+}
+
+func idRangeStrategyFields(rules []string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"range": {
+			Type:        schema.TypeList,
+			Description: "The allowed ID ranges, required by `MustRunAs`.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"max": {
+						Type:        schema.TypeInt,
+						Description: "The end of the range, inclusive.",
+						Required:    true,
+					},
+					"min": {
+						Type:        schema.TypeInt,
+						Description: "The start of the range, inclusive.",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"rule": {
+			Type:         schema.TypeString,
+			Description:  "The strategy that will dictate the allowable IDs that may be set.",
+			Required:     true,
+			ValidateFunc: validateAttributeValueIsIn(rules),
+		},
+	}
+}
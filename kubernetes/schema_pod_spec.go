@@ -12,6 +12,15 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 			ValidateFunc: validatePositiveInteger,
 			Description:  "Optional duration in seconds the pod may be active on the node relative to StartTime before the system will actively try to mark it failed and kill associated containers. Value must be a positive integer.",
 		},
+		"affinity": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "If specified, the pod's scheduling constraints",
+			Elem: &schema.Resource{
+				Schema: affinityFields(),
+			},
+		},
 		"container": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -23,17 +32,78 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 		"init_container": {
 			Type:        schema.TypeList,
 			Optional:    true,
-			ForceNew:    true,
 			Description: "List of init containers belonging to the pod. Init containers always run to completion and each must complete succesfully before the next is started. More info: https://kubernetes.io/docs/concepts/workloads/pods/init-containers/",
 			Elem: &schema.Resource{
 				Schema: containerFields(isUpdatable, true),
 			},
 		},
+		"dns_config": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Specifies the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS configuration based on `dns_policy`.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"nameservers": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "A list of DNS name server IP addresses. This will be appended to the base nameservers generated from `dns_policy`. Duplicated nameservers will be removed.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"option": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "A list of DNS resolver options. This will be merged with the base options generated from `dns_policy`. Duplicated entries will be removed. Resolution options given in `option` will override those that appear in the base `dns_policy`.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "Name of the option.",
+								},
+								"value": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "Value of the option. Optional: Defaults to empty.",
+								},
+							},
+						},
+					},
+					"searches": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "A list of DNS search domains for host-name lookup. This will be appended to the base search paths generated from `dns_policy`. Duplicated search paths will be removed.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
 		"dns_policy": {
-			Type:        schema.TypeString,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "ClusterFirst",
+			Description:  "Set DNS policy for containers within the pod. One of 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in `dns_config` will be merged with the policy selected here, 'None' ignores the DNS settings of the cluster entirely. Defaults to 'ClusterFirst'.",
+			ValidateFunc: validateDNSPolicy,
+		},
+		"host_aliases": {
+			Type:        schema.TypeList,
 			Optional:    true,
-			Default:     "ClusterFirst",
-			Description: "Set DNS policy for containers within the pod. One of 'ClusterFirst' or 'Default'. Defaults to 'ClusterFirst'.",
+			Description: "List of hosts and IPs that will be injected into the pod's hosts file if specified. This is only valid for non-hostNetwork pods.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hostnames": {
+						Type:        schema.TypeList,
+						Required:    true,
+						Description: "Hostnames for the IP address.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"ip": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "IP address of the host file entry.",
+					},
+				},
+			},
 		},
 		"host_ipc": {
 			Type:        schema.TypeBool,
@@ -87,12 +157,23 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 			Optional:    true,
 			Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: http://kubernetes.io/docs/user-guide/node-selection.",
 		},
+		"priority_class_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: `If specified, indicates the pod's priority. "system-node-critical" and "system-cluster-critical" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default.`,
+		},
 		"restart_policy": {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Default:     "Always",
 			Description: "Restart policy for all containers within the pod. One of Always, OnFailure, Never. More info: http://kubernetes.io/docs/user-guide/pod-states#restartpolicy.",
 		},
+		"scheduler_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "If specified, the pod will be dispatched by specified scheduler. If not specified, the pod will be dispatched by default scheduler.",
+		},
 		"security_context": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -191,7 +272,7 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 						Type:        schema.TypeInt,
 						Description: "",
 						Optional:    true,
-						Default: -1,
+						Default:     -1,
 					},
 					"value": {
 						Type:        schema.TypeString,
@@ -304,72 +385,18 @@ func volumeSchema() *schema.Resource {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"default_mode": {
-					Type:        schema.TypeInt,
-					Description: "Optional: mode bits to use on created files by default. Must be a value between 0 and 0777. Defaults to 0644. Directories within the path are not affected by this setting. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.",
-					Optional:    true,
+					Type:         schema.TypeInt,
+					Description:  "Optional: mode bits to use on created files by default. Must be a value between 0 and 0777. Defaults to 0644. Directories within the path are not affected by this setting. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.",
+					Optional:     true,
+					Default:      0644,
+					ValidateFunc: validateModeBits,
 				},
 				"items": {
 					Type:        schema.TypeList,
 					Description: `If unspecified, each key-value pair in the Data field of the referenced ConfigMap will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present. If a key is specified which is not present in the ConfigMap, the volume setup will error. Paths must be relative and may not contain the '..' path or start with '..'.`,
 					Optional:    true,
 					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"field_ref": {
-								Type:        schema.TypeList,
-								Required:    true,
-								MaxItems:    1,
-								Description: "Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.",
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"api_version": {
-											Type:        schema.TypeString,
-											Optional:    true,
-											Default:     "v1",
-											Description: `Version of the schema the FieldPath is written in terms of, defaults to "v1".`,
-										},
-										"field_path": {
-											Type:        schema.TypeString,
-											Optional:    true,
-											Description: "Path of the field to select in the specified API version",
-										},
-									},
-								},
-							},
-							"mode": {
-								Type:        schema.TypeInt,
-								Optional:    true,
-								Description: `Optional: mode bits to use on this file, must be a value between 0 and 0777. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.`,
-							},
-							"path": {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: validateAttributeValueDoesNotContain(".."),
-								Description:  `Path is the relative path name of the file to be created. Must not be absolute or contain the '..' path. Must be utf-8 encoded. The first item of the relative path must not start with '..'`,
-							},
-							"resource_field_ref": {
-								Type:        schema.TypeList,
-								Optional:    true,
-								MaxItems:    1,
-								Description: "Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.",
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"container_name": {
-											Type:     schema.TypeString,
-											Required: true,
-										},
-										"quantity": {
-											Type:     schema.TypeString,
-											Optional: true,
-										},
-										"resource": {
-											Type:        schema.TypeString,
-											Required:    true,
-											Description: "Resource to select",
-										},
-									},
-								},
-							},
-						},
+						Schema: downwardAPIVolumeFileFields(),
 					},
 				},
 			},
@@ -390,10 +417,27 @@ func volumeSchema() *schema.Resource {
 					Default:      "",
 					ValidateFunc: validateAttributeValueIsIn([]string{"", "Memory"}),
 				},
+				"size_limit": {
+					Type:             schema.TypeString,
+					Description:      "Total amount of local storage required for this EmptyDir volume, e.g. `1Gi`. The size limit is also applicable for memory medium. The default is nil which means that the limit is undefined. More info: http://kubernetes.io/docs/user-guide/volumes#emptydir",
+					Optional:         true,
+					ValidateFunc:     validateResourceQuantity,
+					DiffSuppressFunc: suppressEquivalentResourceQuantity,
+				},
 			},
 		},
 	}
 
+	v["projected"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Projected represents a projected volume that should populate this volume, i.e. items from several Secrets, ConfigMaps and the downward API mapped into the same directory.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: projectedVolumeSourceFields(),
+		},
+	}
+
 	v["persistent_volume_claim"] = &schema.Schema{
 		Type:        schema.TypeList,
 		Description: "The specification of a persistent volume.",
@@ -1,5 +1,5 @@
-
 package kubernetes
+
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -15,9 +15,10 @@ func statefulsetSpecFields() map[string]*schema.Schema {
 		"selector": {
 			Type:     schema.TypeList,
 			Required: true,
+			ForceNew: true,
 			MaxItems: 1,
 			Elem: &schema.Resource{
-				Schema: labelSelectorFields(),
+				Schema: labelSelectorFields(false),
 			},
 		},
 		"template": {
@@ -33,6 +34,7 @@ func statefulsetSpecFields() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 			Description: "",
 			Optional:    true,
+			ForceNew:    true,
 			Elem: &schema.Resource{
 				Schema: statefulsetPersistentVolumeClaimFields(),
 			},
@@ -40,18 +42,20 @@ func statefulsetSpecFields() map[string]*schema.Schema {
 		"service_name": {
 			Type:     schema.TypeString,
 			Required: true,
+			ForceNew: true,
 		},
 		"pod_management_policy": {
 			Type:     schema.TypeString,
 			Optional: true,
 			Computed: true,
+			ForceNew: true,
 		},
 		"update_strategy": {
 			Type:        schema.TypeList,
 			Description: "",
 			Optional:    true,
-			Computed: true,
-			MaxItems: 1,
+			Computed:    true,
+			MaxItems:    1,
 			Elem: &schema.Resource{
 				Schema: statefulsetUpdateSpecFields(),
 			},
@@ -60,6 +64,7 @@ func statefulsetSpecFields() map[string]*schema.Schema {
 			Type:     schema.TypeInt,
 			Optional: true,
 			Computed: true,
+			ForceNew: true,
 		},
 	}
 }
@@ -73,7 +78,7 @@ func statefulsetTemplateFields() map[string]*schema.Schema {
 			Required:    true,
 			MaxItems:    1,
 			Elem: &schema.Resource{
-				Schema: podSpecFields(false),
+				Schema: podSpecFields(true),
 			},
 		},
 	}
@@ -90,7 +95,7 @@ func statefulsetUpdateSpecFields() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 			Description: "",
 			Optional:    true,
-			Computed: true,
+			Computed:    true,
 			MaxItems:    1,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
@@ -130,7 +135,7 @@ func statefulsetPersistentVolumeClaimFields() map[string]*schema.Schema {
 						ForceNew:    true,
 						MaxItems:    1,
 						Elem: &schema.Resource{
-							Schema: labelSelectorFields(),
+							Schema: labelSelectorFields(false),
 						},
 					},
 					"volume_name": {
@@ -166,4 +171,4 @@ func statefulsetPersistentVolumeClaimFields() map[string]*schema.Schema {
 			},
 		},
 	}
-}
\ No newline at end of file
+}
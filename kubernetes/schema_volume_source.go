@@ -10,6 +10,184 @@ func persistentVolumeSourceSchema() *schema.Resource {
 	}
 }
 
+func projectedVolumeSourceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"default_mode": {
+			Type:         schema.TypeInt,
+			Description:  "Optional: mode bits to use on created files by default. Must be a value between 0 and 0777. Defaults to 0644. Directories within the path are not affected by this setting. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.",
+			Optional:     true,
+			Default:      0644,
+			ValidateFunc: validateModeBits,
+		},
+		"sources": {
+			Type:        schema.TypeList,
+			Description: "List of volume projections",
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"config_map": {
+						Type:        schema.TypeList,
+						Description: "Information about the ConfigMap data to project",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"items": {
+									Type:        schema.TypeList,
+									Description: "If unspecified, each key-value pair in the Data field of the referenced ConfigMap will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present.",
+									Optional:    true,
+									Elem: &schema.Resource{
+										Schema: keyToPathFields(),
+									},
+								},
+								"name": {
+									Type:        schema.TypeString,
+									Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+									Optional:    true,
+								},
+								"optional": {
+									Type:        schema.TypeBool,
+									Description: "Optional: Specify whether the ConfigMap or it's keys must be defined.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"downward_api": {
+						Type:        schema.TypeList,
+						Description: "Information about the downward API data to project",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"items": {
+									Type:        schema.TypeList,
+									Description: "Represents a volume containing downward API info. Downward API volume supports only annotations, labels, name, namespace and resources of the containers.",
+									Optional:    true,
+									Elem: &schema.Resource{
+										Schema: downwardAPIVolumeFileFields(),
+									},
+								},
+							},
+						},
+					},
+					"secret": {
+						Type:        schema.TypeList,
+						Description: "Information about the Secret data to project",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"items": {
+									Type:        schema.TypeList,
+									Description: "If unspecified, each key-value pair in the Data field of the referenced Secret will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present.",
+									Optional:    true,
+									Elem: &schema.Resource{
+										Schema: keyToPathFields(),
+									},
+								},
+								"name": {
+									Type:        schema.TypeString,
+									Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+									Optional:    true,
+								},
+								"optional": {
+									Type:        schema.TypeBool,
+									Description: "Optional: Specify whether the Secret or it's keys must be defined.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func keyToPathFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The key to project.",
+		},
+		"mode": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validateModeBits,
+			Description:  "Optional: mode bits to use on this file, must be a value between 0 and 0777. If not specified, the volume defaultMode will be used.",
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateAttributeValueDoesNotContain(".."),
+			Description:  "The relative path of the file to map the key to. May not be an absolute path. May not contain the path element '..'. May not start with the string '..'.",
+		},
+	}
+}
+
+func downwardAPIVolumeFileFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"field_ref": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"api_version": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "v1",
+						Description: `Version of the schema the FieldPath is written in terms of, defaults to "v1".`,
+					},
+					"field_path": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Path of the field to select in the specified API version",
+					},
+				},
+			},
+		},
+		"mode": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: `Optional: mode bits to use on this file, must be a value between 0 and 0777. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.`,
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateAttributeValueDoesNotContain(".."),
+			Description:  `Path is the relative path name of the file to be created. Must not be absolute or contain the '..' path. Must be utf-8 encoded. The first item of the relative path must not start with '..'`,
+		},
+		"resource_field_ref": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"container_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"quantity": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"resource": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Resource to select",
+					},
+				},
+			},
+		},
+	}
+}
+
 // Common volume sources between Persistent Volumes and Pod Volumes
 func commonVolumeSources() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -0,0 +1,130 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func webhookConfigurationSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The list of " + kind + " webhooks and the affected resources and operations.",
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: webhookFields(),
+		},
+	}
+}
+
+func webhookFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"client_config": {
+			Type:        schema.TypeList,
+			Description: "Defines how to communicate with the webhook.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ca_bundle": {
+						Type:        schema.TypeString,
+						Description: "A PEM encoded CA bundle which will be used to validate the webhook's server certificate. The bundle is base64 encoded by the provider, like the data of a secret.",
+						Optional:    true,
+					},
+					"service": {
+						Type:        schema.TypeList,
+						Description: "A reference to the service of the webhook. Exactly one of `service` or `url` must be specified.",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Description: "The name of the service.",
+									Required:    true,
+								},
+								"namespace": {
+									Type:        schema.TypeString,
+									Description: "The namespace of the service.",
+									Optional:    true,
+									Default:     "default",
+								},
+								"path": {
+									Type:        schema.TypeString,
+									Description: "An optional URL path which will be sent in any request to the service.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"url": {
+						Type:         schema.TypeString,
+						Description:  "The location of the webhook in standard URL form (`https://host:port/path`). Exactly one of `service` or `url` must be specified.",
+						Optional:     true,
+						ValidateFunc: validateWebhookURL,
+					},
+				},
+			},
+		},
+		"failure_policy": {
+			Type:         schema.TypeString,
+			Description:  "Defines how errors from the admission endpoint are handled, `Ignore` or `Fail`. Defaults to `Ignore`.",
+			Optional:     true,
+			Default:      "Ignore",
+			ValidateFunc: validateAttributeValueIsIn([]string{"Fail", "Ignore"}),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The fully qualified name of the webhook, e.g. `imagepolicy.kubernetes.io`.",
+			Required:    true,
+		},
+		"namespace_selector": {
+			Type:        schema.TypeList,
+			Description: "Decides whether to run the webhook on an object based on whether the namespace of that object matches the selector. Defaults to matching every namespace.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(true),
+			},
+		},
+		"rule": {
+			Type:        schema.TypeList,
+			Description: "Describes which operations on which resources the webhook cares about. The webhook cares about an operation if it matches any rule.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"api_groups": {
+						Type:        schema.TypeSet,
+						Description: "The API groups the resources belong to. `*` is all groups.",
+						Required:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Set:         schema.HashString,
+					},
+					"api_versions": {
+						Type:        schema.TypeSet,
+						Description: "The API versions the resources belong to. `*` is all versions.",
+						Required:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Set:         schema.HashString,
+					},
+					"operations": {
+						Type:        schema.TypeSet,
+						Description: "The operations the webhook cares about: `CREATE`, `UPDATE`, `DELETE`, `CONNECT` or `*` for all operations.",
+						Required:    true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validateAttributeValueIsIn([]string{"*", "CREATE", "UPDATE", "DELETE", "CONNECT"}),
+						},
+						Set: schema.HashString,
+					},
+					"resources": {
+						Type:        schema.TypeSet,
+						Description: "The resources the rule applies to, e.g. `pods`, `pods/log` for a subresource or `*` for all resources.",
+						Required:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Set:         schema.HashString,
+					},
+				},
+			},
+		},
+	}
+}
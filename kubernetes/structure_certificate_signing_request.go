@@ -0,0 +1,86 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/certificates/v1beta1"
+)
+
+var certificateKeyUsages = []string{
+	string(api.UsageSigning),
+	string(api.UsageDigitalSignature),
+	string(api.UsageContentCommittment),
+	string(api.UsageKeyEncipherment),
+	string(api.UsageKeyAgreement),
+	string(api.UsageDataEncipherment),
+	string(api.UsageCertSign),
+	string(api.UsageCRLSign),
+	string(api.UsageEncipherOnly),
+	string(api.UsageDecipherOnly),
+	string(api.UsageAny),
+	string(api.UsageServerAuth),
+	string(api.UsageClientAuth),
+	string(api.UsageCodeSigning),
+	string(api.UsageEmailProtection),
+	string(api.UsageSMIME),
+	string(api.UsageIPsecEndSystem),
+	string(api.UsageIPsecTunnel),
+	string(api.UsageIPsecUser),
+	string(api.UsageTimestamping),
+	string(api.UsageOCSPSigning),
+	string(api.UsageMicrosoftSGC),
+	string(api.UsageNetscapSGC),
+}
+
+// certificateSigningRequestState returns Issued once the certificate is
+// available, Denied or Approved depending on the conditions and otherwise
+// Pending
+func certificateSigningRequestState(csr *api.CertificateSigningRequest) string {
+	if len(csr.Status.Certificate) > 0 {
+		return "Issued"
+	}
+	state := "Pending"
+	for _, c := range csr.Status.Conditions {
+		switch c.Type {
+		case api.CertificateDenied:
+			return "Denied"
+		case api.CertificateApproved:
+			state = "Approved"
+		}
+	}
+	return state
+}
+
+// Flatteners
+
+func flattenCertificateSigningRequestSpec(in api.CertificateSigningRequestSpec) []interface{} {
+	usages := make([]string, len(in.Usages))
+	for i, u := range in.Usages {
+		usages[i] = string(u)
+	}
+	// The request is kept decoded, like the data of a secret, []byte
+	// fields are base64 encoded when marshalled to JSON
+	att := map[string]interface{}{
+		"request": string(in.Request),
+		"usages":  newStringSet(schema.HashString, usages),
+	}
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandCertificateSigningRequestSpec(l []interface{}) api.CertificateSigningRequestSpec {
+	obj := api.CertificateSigningRequestSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	obj.Request = []byte(in["request"].(string))
+	if v, ok := in["usages"].(*schema.Set); ok && v.Len() > 0 {
+		usages := schemaSetToStringArray(v)
+		obj.Usages = make([]api.KeyUsage, len(usages))
+		for i, u := range usages {
+			obj.Usages[i] = api.KeyUsage(u)
+		}
+	}
+	return obj
+}
@@ -0,0 +1,63 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	api "k8s.io/api/certificates/v1beta1"
+)
+
+func TestCertificateSigningRequestSpecRoundTrip(t *testing.T) {
+	cases := []api.CertificateSigningRequestSpec{
+		{
+			Request: []byte("-----BEGIN CERTIFICATE REQUEST-----\nMIIB\n-----END CERTIFICATE REQUEST-----\n"),
+			Usages:  []api.KeyUsage{api.UsageClientAuth},
+		},
+		{
+			Request: []byte("-----BEGIN CERTIFICATE REQUEST-----\nMIIC\n-----END CERTIFICATE REQUEST-----\n"),
+		},
+	}
+
+	for _, tc := range cases {
+		d := testStateData(t, resourceKubernetesCertificateSigningRequest().Schema, "spec", flattenCertificateSigningRequestSpec(tc))
+		output := expandCertificateSigningRequestSpec(d.Get("spec").([]interface{}))
+		if !reflect.DeepEqual(output, tc) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", tc, output)
+		}
+	}
+}
+
+func TestCertificateSigningRequestState(t *testing.T) {
+	cases := []struct {
+		Status   api.CertificateSigningRequestStatus
+		Expected string
+	}{
+		{api.CertificateSigningRequestStatus{}, "Pending"},
+		{
+			api.CertificateSigningRequestStatus{
+				Conditions: []api.CertificateSigningRequestCondition{{Type: api.CertificateApproved}},
+			},
+			"Approved",
+		},
+		{
+			api.CertificateSigningRequestStatus{
+				Conditions: []api.CertificateSigningRequestCondition{{Type: api.CertificateApproved}, {Type: api.CertificateDenied}},
+			},
+			"Denied",
+		},
+		{
+			api.CertificateSigningRequestStatus{
+				Conditions:  []api.CertificateSigningRequestCondition{{Type: api.CertificateApproved}},
+				Certificate: []byte("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"),
+			},
+			"Issued",
+		},
+	}
+
+	for _, tc := range cases {
+		state := certificateSigningRequestState(&api.CertificateSigningRequest{Status: tc.Status})
+		if state != tc.Expected {
+			t.Fatalf("Unexpected state of %#v.\nExpected: %q\nGiven:    %q", tc.Status, tc.Expected, state)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The apiextensions.k8s.io types aren't part of the vendored client, the
+// following types mirror the parts of CustomResourceDefinition v1beta1 we
+// manage and are sent to the API through the discovery REST client, like
+// the objects of kubernetes_manifest
+
+type customResourceDefinition struct {
+	meta_v1.TypeMeta   `json:",inline"`
+	meta_v1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   customResourceDefinitionSpec   `json:"spec"`
+	Status customResourceDefinitionStatus `json:"status,omitempty"`
+}
+
+type customResourceDefinitionSpec struct {
+	Group        string                        `json:"group"`
+	Version      string                        `json:"version"`
+	Names        customResourceDefinitionNames `json:"names"`
+	Scope        string                        `json:"scope"`
+	Validation   *customResourceValidation     `json:"validation,omitempty"`
+	Subresources *customResourceSubresources   `json:"subresources,omitempty"`
+}
+
+type customResourceDefinitionNames struct {
+	Plural     string   `json:"plural"`
+	Singular   string   `json:"singular,omitempty"`
+	ShortNames []string `json:"shortNames,omitempty"`
+	Kind       string   `json:"kind"`
+	ListKind   string   `json:"listKind,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+}
+
+type customResourceValidation struct {
+	OpenAPIV3Schema map[string]interface{} `json:"openAPIV3Schema,omitempty"`
+}
+
+type customResourceSubresources struct {
+	Status *customResourceSubresourceStatus `json:"status,omitempty"`
+	Scale  *customResourceSubresourceScale  `json:"scale,omitempty"`
+}
+
+type customResourceSubresourceStatus struct{}
+
+type customResourceSubresourceScale struct {
+	SpecReplicasPath   string  `json:"specReplicasPath"`
+	StatusReplicasPath string  `json:"statusReplicasPath"`
+	LabelSelectorPath  *string `json:"labelSelectorPath,omitempty"`
+}
+
+type customResourceDefinitionStatus struct {
+	Conditions []customResourceDefinitionCondition `json:"conditions,omitempty"`
+}
+
+type customResourceDefinitionCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// customResourceDefinitionState returns Established once the definition is
+// served, NamesNotAccepted if its names conflict with another definition and
+// Pending otherwise
+func customResourceDefinitionState(crd *customResourceDefinition) (string, string) {
+	for _, c := range crd.Status.Conditions {
+		switch {
+		case c.Type == "Established" && c.Status == "True":
+			return "Established", c.Message
+		case c.Type == "NamesAccepted" && c.Status == "False":
+			return "NamesNotAccepted", c.Message
+		}
+	}
+	return "Pending", ""
+}
+
+// Flatteners
+
+func flattenCustomResourceDefinitionSpec(in customResourceDefinitionSpec) ([]interface{}, error) {
+	att := map[string]interface{}{
+		"group":   in.Group,
+		"names":   flattenCustomResourceDefinitionNames(in.Names),
+		"scope":   in.Scope,
+		"version": in.Version,
+	}
+	if in.Subresources != nil {
+		subresources := map[string]interface{}{
+			"status": in.Subresources.Status != nil,
+		}
+		if s := in.Subresources.Scale; s != nil {
+			scale := map[string]interface{}{
+				"spec_replicas_path":   s.SpecReplicasPath,
+				"status_replicas_path": s.StatusReplicasPath,
+			}
+			if s.LabelSelectorPath != nil {
+				scale["label_selector_path"] = *s.LabelSelectorPath
+			}
+			subresources["scale"] = []interface{}{scale}
+		}
+		att["subresources"] = []interface{}{subresources}
+	}
+	if in.Validation != nil && len(in.Validation.OpenAPIV3Schema) > 0 {
+		data, err := json.Marshal(in.Validation.OpenAPIV3Schema)
+		if err != nil {
+			return nil, err
+		}
+		att["validation"] = string(data)
+	}
+	return []interface{}{att}, nil
+}
+
+func flattenCustomResourceDefinitionNames(in customResourceDefinitionNames) []interface{} {
+	att := map[string]interface{}{
+		"kind":      in.Kind,
+		"list_kind": in.ListKind,
+		"plural":    in.Plural,
+		"singular":  in.Singular,
+	}
+	if len(in.Categories) > 0 {
+		att["categories"] = newStringSet(schema.HashString, in.Categories)
+	}
+	if len(in.ShortNames) > 0 {
+		att["short_names"] = newStringSet(schema.HashString, in.ShortNames)
+	}
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandCustomResourceDefinitionSpec(l []interface{}) (customResourceDefinitionSpec, error) {
+	obj := customResourceDefinitionSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+	obj.Group = in["group"].(string)
+	obj.Version = in["version"].(string)
+	obj.Scope = in["scope"].(string)
+	obj.Names = expandCustomResourceDefinitionNames(in["names"].([]interface{}))
+	if v, ok := in["subresources"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		obj.Subresources = expandCustomResourceSubresources(v[0].(map[string]interface{}))
+	}
+	if v, ok := in["validation"].(string); ok && v != "" {
+		openAPISchema, err := parseManifest(v)
+		if err != nil {
+			return obj, err
+		}
+		obj.Validation = &customResourceValidation{OpenAPIV3Schema: openAPISchema}
+	}
+	return obj, nil
+}
+
+func expandCustomResourceDefinitionNames(l []interface{}) customResourceDefinitionNames {
+	obj := customResourceDefinitionNames{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	obj.Kind = in["kind"].(string)
+	obj.Plural = in["plural"].(string)
+	if v, ok := in["list_kind"].(string); ok {
+		obj.ListKind = v
+	}
+	if v, ok := in["singular"].(string); ok {
+		obj.Singular = v
+	}
+	if v, ok := in["categories"].(*schema.Set); ok && v.Len() > 0 {
+		obj.Categories = schemaSetToStringArray(v)
+	}
+	if v, ok := in["short_names"].(*schema.Set); ok && v.Len() > 0 {
+		obj.ShortNames = schemaSetToStringArray(v)
+	}
+	return obj
+}
+
+func expandCustomResourceSubresources(in map[string]interface{}) *customResourceSubresources {
+	obj := &customResourceSubresources{}
+	if v, ok := in["status"].(bool); ok && v {
+		obj.Status = &customResourceSubresourceStatus{}
+	}
+	if v, ok := in["scale"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		scale := v[0].(map[string]interface{})
+		obj.Scale = &customResourceSubresourceScale{
+			SpecReplicasPath:   scale["spec_replicas_path"].(string),
+			StatusReplicasPath: scale["status_replicas_path"].(string),
+		}
+		if p, ok := scale["label_selector_path"].(string); ok && p != "" {
+			obj.Scale.LabelSelectorPath = ptrToString(p)
+		}
+	}
+	return obj
+}
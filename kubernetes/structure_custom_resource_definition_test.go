@@ -0,0 +1,110 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCustomResourceDefinitionSpecRoundTrip(t *testing.T) {
+	cases := []customResourceDefinitionSpec{
+		{
+			Group:   "stable.example.com",
+			Version: "v1",
+			Scope:   "Namespaced",
+			Names: customResourceDefinitionNames{
+				Plural:     "crontabs",
+				Singular:   "crontab",
+				Kind:       "CronTab",
+				ListKind:   "CronTabList",
+				ShortNames: []string{"ct"},
+				Categories: []string{"all"},
+			},
+			Validation: &customResourceValidation{
+				OpenAPIV3Schema: map[string]interface{}{
+					"properties": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"required": []interface{}{"cronSpec"},
+							"properties": map[string]interface{}{
+								"replicas": map[string]interface{}{"type": "integer", "minimum": float64(1)},
+							},
+						},
+					},
+				},
+			},
+			Subresources: &customResourceSubresources{
+				Status: &customResourceSubresourceStatus{},
+				Scale: &customResourceSubresourceScale{
+					SpecReplicasPath:   ".spec.replicas",
+					StatusReplicasPath: ".status.replicas",
+					LabelSelectorPath:  ptrToString(".status.labelSelector"),
+				},
+			},
+		},
+		{
+			Group:   "example.com",
+			Version: "v1alpha1",
+			Scope:   "Cluster",
+			Names: customResourceDefinitionNames{
+				Plural:   "widgets",
+				Singular: "widget",
+				Kind:     "Widget",
+				ListKind: "WidgetList",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		flattened, err := flattenCustomResourceDefinitionSpec(tc)
+		if err != nil {
+			t.Fatalf("Unexpected error from flattener: %s", err)
+		}
+		d := testStateData(t, resourceKubernetesCustomResourceDefinition().Schema, "spec", flattened)
+		output, err := expandCustomResourceDefinitionSpec(d.Get("spec").([]interface{}))
+		if err != nil {
+			t.Fatalf("Unexpected error from expander: %s", err)
+		}
+		if !reflect.DeepEqual(output, tc) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", tc, output)
+		}
+	}
+}
+
+func TestCustomResourceDefinitionState(t *testing.T) {
+	cases := []struct {
+		Conditions []customResourceDefinitionCondition
+		Expected   string
+	}{
+		{nil, "Pending"},
+		{
+			[]customResourceDefinitionCondition{
+				{Type: "NamesAccepted", Status: "True"},
+				{Type: "Established", Status: "False"},
+			},
+			"Pending",
+		},
+		{
+			[]customResourceDefinitionCondition{
+				{Type: "NamesAccepted", Status: "True"},
+				{Type: "Established", Status: "True"},
+			},
+			"Established",
+		},
+		{
+			[]customResourceDefinitionCondition{
+				{Type: "NamesAccepted", Status: "False", Message: "\"crontabs\" is already in use"},
+				{Type: "Established", Status: "False"},
+			},
+			"NamesNotAccepted",
+		},
+	}
+
+	for _, tc := range cases {
+		crd := &customResourceDefinition{
+			Status: customResourceDefinitionStatus{Conditions: tc.Conditions},
+		}
+		state, _ := customResourceDefinitionState(crd)
+		if state != tc.Expected {
+			t.Fatalf("Unexpected state of %#v.\nExpected: %q\nGiven:    %q", tc.Conditions, tc.Expected, state)
+		}
+	}
+}
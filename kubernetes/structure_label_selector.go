@@ -26,7 +26,7 @@ func flattenLabelSelectorRequirement(in []metav1.LabelSelectorRequirement) []int
 	for i, n := range in {
 		m := make(map[string]interface{})
 		m["key"] = n.Key
-		m["operator"] = n.Operator
+		m["operator"] = string(n.Operator)
 		m["values"] = newStringSet(schema.HashString, n.Values)
 		att[i] = m
 	}
@@ -0,0 +1,208 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/hashicorp/terraform/helper/schema"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// manifestIdentity holds the fields which identify the object described by
+// a manifest and which can't be changed without recreating it
+type manifestIdentity struct {
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+}
+
+// String returns the resource ID, e.g.
+// apiVersion=apps/v1,kind=Deployment,namespace=default,name=web
+func (i manifestIdentity) String() string {
+	parts := []string{
+		"apiVersion=" + i.APIVersion,
+		"kind=" + i.Kind,
+	}
+	if i.Namespace != "" {
+		parts = append(parts, "namespace="+i.Namespace)
+	}
+	parts = append(parts, "name="+i.Name)
+	return strings.Join(parts, ",")
+}
+
+func parseManifestId(id string) (manifestIdentity, error) {
+	identity := manifestIdentity{}
+	for _, part := range strings.Split(id, ",") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return identity, fmt.Errorf("Unexpected ID format (%q), expected %q.", id, "apiVersion=<version>,kind=<kind>,namespace=<namespace>,name=<name>")
+		}
+		switch kv[0] {
+		case "apiVersion":
+			identity.APIVersion = kv[1]
+		case "kind":
+			identity.Kind = kv[1]
+		case "namespace":
+			identity.Namespace = kv[1]
+		case "name":
+			identity.Name = kv[1]
+		default:
+			return identity, fmt.Errorf("Unexpected key %q in ID %q", kv[0], id)
+		}
+	}
+	if identity.APIVersion == "" || identity.Kind == "" || identity.Name == "" {
+		return identity, fmt.Errorf("ID %q must contain at least apiVersion, kind and name", id)
+	}
+	return identity, nil
+}
+
+// parseManifest accepts a single object as either YAML or JSON
+func parseManifest(manifest string) (map[string]interface{}, error) {
+	data, err := yaml.YAMLToJSON([]byte(manifest))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse manifest: %s", err)
+	}
+	var obj map[string]interface{}
+	err = json.Unmarshal(data, &obj)
+	if err != nil {
+		return nil, fmt.Errorf("Manifest must describe a single object: %s", err)
+	}
+	return obj, nil
+}
+
+func manifestObjectIdentity(obj map[string]interface{}) (manifestIdentity, error) {
+	identity := manifestIdentity{}
+	identity.APIVersion, _ = obj["apiVersion"].(string)
+	identity.Kind, _ = obj["kind"].(string)
+	if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+		identity.Namespace, _ = metadata["namespace"].(string)
+		identity.Name, _ = metadata["name"].(string)
+	}
+	if identity.APIVersion == "" || identity.Kind == "" || identity.Name == "" {
+		return identity, fmt.Errorf("Manifest must contain apiVersion, kind and metadata.name")
+	}
+	return identity, nil
+}
+
+// normalizeManifest returns a canonical JSON representation of the manifest
+// so that formatting differences don't show up as a diff
+func normalizeManifest(manifest string) (string, error) {
+	obj, err := parseManifest(manifest)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func suppressEquivalentManifests(k, old, new string, d *schema.ResourceData) bool {
+	oldNormalized, err := normalizeManifest(old)
+	if err != nil {
+		return false
+	}
+	newNormalized, err := normalizeManifest(new)
+	if err != nil {
+		return false
+	}
+	return oldNormalized == newNormalized
+}
+
+// projectManifest returns the parts of the live object which are present
+// in the manifest, so that drift is only detected for the fields we manage
+func projectManifest(manifest, live interface{}) interface{} {
+	switch m := manifest.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		out := make(map[string]interface{})
+		for k, v := range m {
+			if lv, ok := l[k]; ok {
+				out[k] = projectManifest(v, lv)
+			}
+		}
+		return out
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(m) {
+			return live
+		}
+		out := make([]interface{}, len(l), len(l))
+		for i, v := range m {
+			out[i] = projectManifest(v, l[i])
+		}
+		return out
+	default:
+		return live
+	}
+}
+
+// cleanLiveObject drops the fields managed by the server, it's used
+// to build a manifest for imported objects
+func cleanLiveObject(obj map[string]interface{}) map[string]interface{} {
+	delete(obj, "status")
+	if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+		for _, k := range []string{"creationTimestamp", "generation", "resourceVersion", "selfLink", "uid"} {
+			delete(metadata, k)
+		}
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok && len(annotations) == 0 {
+			delete(metadata, "annotations")
+		}
+	}
+	return obj
+}
+
+// createManifestMergePatch builds a JSON merge patch (RFC 7386) which turns
+// the old manifest into the new one, removing fields dropped from the manifest
+func createManifestMergePatch(old, new map[string]interface{}) map[string]interface{} {
+	patch := make(map[string]interface{})
+	for k := range old {
+		if _, ok := new[k]; !ok {
+			patch[k] = nil
+		}
+	}
+	for k, v := range new {
+		ov, ok := old[k]
+		if !ok {
+			patch[k] = v
+			continue
+		}
+		oldMap, oldIsMap := ov.(map[string]interface{})
+		newMap, newIsMap := v.(map[string]interface{})
+		if oldIsMap && newIsMap {
+			if nested := createManifestMergePatch(oldMap, newMap); len(nested) > 0 {
+				patch[k] = nested
+			}
+			continue
+		}
+		if !reflect.DeepEqual(ov, v) {
+			patch[k] = v
+		}
+	}
+	return patch
+}
+
+// manifestResourcePath builds the REST path of a resource, or of the
+// collection it belongs to when name is empty
+func manifestResourcePath(apiVersion string, resource meta_v1.APIResource, namespace, name string) string {
+	path := "/apis/" + apiVersion
+	if !strings.Contains(apiVersion, "/") {
+		path = "/api/" + apiVersion
+	}
+	if resource.Namespaced {
+		path += "/namespaces/" + namespace
+	}
+	path += "/" + resource.Name
+	if name != "" {
+		path += "/" + name
+	}
+	return path
+}
@@ -0,0 +1,185 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestManifestIdentity(t *testing.T) {
+	cases := []struct {
+		Identity manifestIdentity
+		Id       string
+	}{
+		{
+			manifestIdentity{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "web"},
+			"apiVersion=apps/v1,kind=Deployment,namespace=default,name=web",
+		},
+		{
+			manifestIdentity{APIVersion: "scheduling.k8s.io/v1beta1", Kind: "PriorityClass", Name: "high"},
+			"apiVersion=scheduling.k8s.io/v1beta1,kind=PriorityClass,name=high",
+		},
+	}
+
+	for _, tc := range cases {
+		if id := tc.Identity.String(); id != tc.Id {
+			t.Fatalf("Unexpected ID.\nExpected: %s\nGiven:    %s", tc.Id, id)
+		}
+		identity, err := parseManifestId(tc.Id)
+		if err != nil {
+			t.Fatalf("Unexpected error parsing %q: %s", tc.Id, err)
+		}
+		if identity != tc.Identity {
+			t.Fatalf("Unexpected identity.\nExpected: %#v\nGiven:    %#v", tc.Identity, identity)
+		}
+	}
+
+	for _, id := range []string{"default/web", "apiVersion=v1,kind=ConfigMap", "apiVersion=v1,kind=ConfigMap,name=x,foo=bar"} {
+		if _, err := parseManifestId(id); err == nil {
+			t.Fatalf("Expected %q to be invalid", id)
+		}
+	}
+}
+
+func TestParseManifest(t *testing.T) {
+	yamlManifest := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test
+data:
+  key: value
+`
+	jsonManifest := `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "test"}, "data": {"key": "value"}}`
+
+	if !suppressEquivalentManifests("manifest", yamlManifest, jsonManifest, nil) {
+		t.Fatal("Expected YAML and JSON manifests to be equivalent")
+	}
+
+	obj, err := parseManifest(yamlManifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	identity, err := manifestObjectIdentity(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := manifestIdentity{APIVersion: "v1", Kind: "ConfigMap", Name: "test"}
+	if identity != expected {
+		t.Fatalf("Unexpected identity.\nExpected: %#v\nGiven:    %#v", expected, identity)
+	}
+
+	if _, err := parseManifest("- a\n- b\n"); err == nil {
+		t.Fatal("Expected a list to be rejected")
+	}
+	obj, err = parseManifest("kind: ConfigMap\nmetadata: {}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := manifestObjectIdentity(obj); err == nil {
+		t.Fatal("Expected a manifest without apiVersion and name to be rejected")
+	}
+}
+
+func TestProjectManifest(t *testing.T) {
+	manifest := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   "test",
+			"labels": map[string]interface{}{"app": "web"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": float64(2),
+			"ports": []interface{}{
+				map[string]interface{}{"port": float64(80)},
+			},
+			"args": []interface{}{"a"},
+		},
+	}
+	live := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "test",
+			"labels":          map[string]interface{}{"app": "web", "extra": "x"},
+			"resourceVersion": "123",
+		},
+		"spec": map[string]interface{}{
+			"replicas": float64(3),
+			"ports": []interface{}{
+				map[string]interface{}{"port": float64(80), "protocol": "TCP"},
+			},
+			"args": []interface{}{"a", "b"},
+		},
+		"status": map[string]interface{}{"ready": true},
+	}
+	expected := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   "test",
+			"labels": map[string]interface{}{"app": "web"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": float64(3),
+			"ports": []interface{}{
+				map[string]interface{}{"port": float64(80)},
+			},
+			"args": []interface{}{"a", "b"},
+		},
+	}
+
+	output := projectManifest(manifest, live)
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Unexpected projection.\nExpected: %#v\nGiven:    %#v", expected, output)
+	}
+}
+
+func TestCreateManifestMergePatch(t *testing.T) {
+	old := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   "test",
+			"labels": map[string]interface{}{"app": "web", "tier": "frontend"},
+		},
+		"data": map[string]interface{}{"one": "1"},
+	}
+	new := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   "test",
+			"labels": map[string]interface{}{"app": "api"},
+		},
+		"binaryData": map[string]interface{}{"two": "Mg=="},
+	}
+	expected := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"app": "api", "tier": nil},
+		},
+		"data":       nil,
+		"binaryData": map[string]interface{}{"two": "Mg=="},
+	}
+
+	output := createManifestMergePatch(old, new)
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Unexpected merge patch.\nExpected: %#v\nGiven:    %#v", expected, output)
+	}
+	if patch := createManifestMergePatch(new, new); len(patch) != 0 {
+		t.Fatalf("Expected an empty patch, given: %#v", patch)
+	}
+}
+
+func TestManifestResourcePath(t *testing.T) {
+	cases := []struct {
+		APIVersion string
+		Resource   meta_v1.APIResource
+		Namespace  string
+		Name       string
+		Expected   string
+	}{
+		{"v1", meta_v1.APIResource{Name: "configmaps", Namespaced: true}, "default", "test", "/api/v1/namespaces/default/configmaps/test"},
+		{"apps/v1", meta_v1.APIResource{Name: "deployments", Namespaced: true}, "kube-system", "", "/apis/apps/v1/namespaces/kube-system/deployments"},
+		{"rbac.authorization.k8s.io/v1", meta_v1.APIResource{Name: "clusterroles"}, "", "admin", "/apis/rbac.authorization.k8s.io/v1/clusterroles/admin"},
+	}
+
+	for _, tc := range cases {
+		path := manifestResourcePath(tc.APIVersion, tc.Resource, tc.Namespace, tc.Name)
+		if path != tc.Expected {
+			t.Fatalf("Unexpected path.\nExpected: %s\nGiven:    %s", tc.Expected, path)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/api/core/v1"
+	api "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Flatteners
+
+func flattenNetworkPolicySpec(in api.NetworkPolicySpec) []interface{} {
+	att := make(map[string]interface{})
+	att["pod_selector"] = flattenNetworkPolicySelector(&in.PodSelector)
+	if len(in.Ingress) > 0 {
+		att["ingress"] = flattenNetworkPolicyIngress(in.Ingress)
+	}
+	if len(in.Egress) > 0 {
+		att["egress"] = flattenNetworkPolicyEgress(in.Egress)
+	}
+	if len(in.PolicyTypes) > 0 {
+		policyTypes := make([]interface{}, len(in.PolicyTypes), len(in.PolicyTypes))
+		for i, v := range in.PolicyTypes {
+			policyTypes[i] = string(v)
+		}
+		att["policy_types"] = policyTypes
+	}
+	return []interface{}{att}
+}
+
+// flattenNetworkPolicySelector keeps empty selectors in the state
+// as they select everything rather than nothing
+func flattenNetworkPolicySelector(in *metav1.LabelSelector) []interface{} {
+	selector := flattenLabelSelector(in)
+	if len(selector) == 0 {
+		return []interface{}{map[string]interface{}{}}
+	}
+	return selector
+}
+
+func flattenNetworkPolicyIngress(in []api.NetworkPolicyIngressRule) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		if len(n.Ports) > 0 {
+			m["ports"] = flattenNetworkPolicyPorts(n.Ports)
+		}
+		if len(n.From) > 0 {
+			m["from"] = flattenNetworkPolicyPeers(n.From)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenNetworkPolicyEgress(in []api.NetworkPolicyEgressRule) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		if len(n.Ports) > 0 {
+			m["ports"] = flattenNetworkPolicyPorts(n.Ports)
+		}
+		if len(n.To) > 0 {
+			m["to"] = flattenNetworkPolicyPeers(n.To)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenNetworkPolicyPorts(in []api.NetworkPolicyPort) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		if n.Port != nil {
+			m["port"] = n.Port.String()
+		}
+		if n.Protocol != nil {
+			m["protocol"] = string(*n.Protocol)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenNetworkPolicyPeers(in []api.NetworkPolicyPeer) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		if n.IPBlock != nil {
+			ipBlock := map[string]interface{}{
+				"cidr": n.IPBlock.CIDR,
+			}
+			if len(n.IPBlock.Except) > 0 {
+				ipBlock["except"] = n.IPBlock.Except
+			}
+			m["ip_block"] = []interface{}{ipBlock}
+		}
+		if n.NamespaceSelector != nil {
+			m["namespace_selector"] = flattenNetworkPolicySelector(n.NamespaceSelector)
+		}
+		if n.PodSelector != nil {
+			m["pod_selector"] = flattenNetworkPolicySelector(n.PodSelector)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+// Expanders
+
+func expandNetworkPolicySpec(l []interface{}) api.NetworkPolicySpec {
+	obj := api.NetworkPolicySpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.PodSelector = *expandLabelSelector(in["pod_selector"].([]interface{}))
+	if v, ok := in["ingress"].([]interface{}); ok && len(v) > 0 {
+		obj.Ingress = expandNetworkPolicyIngress(v)
+	}
+	if v, ok := in["egress"].([]interface{}); ok && len(v) > 0 {
+		obj.Egress = expandNetworkPolicyEgress(v)
+	}
+	if v, ok := in["policy_types"].([]interface{}); ok {
+		obj.PolicyTypes = expandNetworkPolicyTypes(v)
+	}
+	return obj
+}
+
+func expandNetworkPolicyIngress(l []interface{}) []api.NetworkPolicyIngressRule {
+	obj := make([]api.NetworkPolicyIngressRule, len(l), len(l))
+	for i, n := range l {
+		if n == nil {
+			continue
+		}
+		in := n.(map[string]interface{})
+		if v, ok := in["ports"].([]interface{}); ok && len(v) > 0 {
+			obj[i].Ports = expandNetworkPolicyPorts(v)
+		}
+		if v, ok := in["from"].([]interface{}); ok && len(v) > 0 {
+			obj[i].From = expandNetworkPolicyPeers(v)
+		}
+	}
+	return obj
+}
+
+func expandNetworkPolicyEgress(l []interface{}) []api.NetworkPolicyEgressRule {
+	obj := make([]api.NetworkPolicyEgressRule, len(l), len(l))
+	for i, n := range l {
+		if n == nil {
+			continue
+		}
+		in := n.(map[string]interface{})
+		if v, ok := in["ports"].([]interface{}); ok && len(v) > 0 {
+			obj[i].Ports = expandNetworkPolicyPorts(v)
+		}
+		if v, ok := in["to"].([]interface{}); ok && len(v) > 0 {
+			obj[i].To = expandNetworkPolicyPeers(v)
+		}
+	}
+	return obj
+}
+
+func expandNetworkPolicyPorts(l []interface{}) []api.NetworkPolicyPort {
+	obj := make([]api.NetworkPolicyPort, len(l), len(l))
+	for i, n := range l {
+		if n == nil {
+			continue
+		}
+		in := n.(map[string]interface{})
+		if v, ok := in["port"].(string); ok && v != "" {
+			port := intstr.Parse(v)
+			obj[i].Port = &port
+		}
+		if v, ok := in["protocol"].(string); ok && v != "" {
+			protocol := v1.Protocol(v)
+			obj[i].Protocol = &protocol
+		}
+	}
+	return obj
+}
+
+func expandNetworkPolicyPeers(l []interface{}) []api.NetworkPolicyPeer {
+	obj := make([]api.NetworkPolicyPeer, len(l), len(l))
+	for i, n := range l {
+		if n == nil {
+			continue
+		}
+		in := n.(map[string]interface{})
+		if v, ok := in["ip_block"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			ipBlock := v[0].(map[string]interface{})
+			obj[i].IPBlock = &api.IPBlock{
+				CIDR: ipBlock["cidr"].(string),
+			}
+			if except, ok := ipBlock["except"].([]interface{}); ok && len(except) > 0 {
+				obj[i].IPBlock.Except = sliceOfString(except)
+			}
+		}
+		if v, ok := in["namespace_selector"].([]interface{}); ok && len(v) > 0 {
+			obj[i].NamespaceSelector = expandLabelSelector(v)
+		}
+		if v, ok := in["pod_selector"].([]interface{}); ok && len(v) > 0 {
+			obj[i].PodSelector = expandLabelSelector(v)
+		}
+	}
+	return obj
+}
+
+func expandNetworkPolicyTypes(l []interface{}) []api.PolicyType {
+	obj := make([]api.PolicyType, len(l), len(l))
+	for i, v := range l {
+		obj[i] = api.PolicyType(v.(string))
+	}
+	return obj
+}
+
+// Patchers
+
+func patchNetworkPolicySpec(pathPrefix, prefix string, d *schema.ResourceData) PatchOperations {
+	ops := make([]PatchOperation, 0)
+
+	if d.HasChange(prefix + "pod_selector") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  pathPrefix + "/podSelector",
+			Value: expandLabelSelector(d.Get(prefix + "pod_selector").([]interface{})),
+		})
+	}
+	if d.HasChange(prefix + "ingress") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "/ingress",
+			Value: expandNetworkPolicyIngress(d.Get(prefix + "ingress").([]interface{})),
+		})
+	}
+	if d.HasChange(prefix + "egress") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "/egress",
+			Value: expandNetworkPolicyEgress(d.Get(prefix + "egress").([]interface{})),
+		})
+	}
+	if d.HasChange(prefix + "policy_types") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  pathPrefix + "/policyTypes",
+			Value: expandNetworkPolicyTypes(d.Get(prefix + "policy_types").([]interface{})),
+		})
+	}
+	return ops
+}
@@ -0,0 +1,166 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	api "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestFlattenNetworkPolicySpec(t *testing.T) {
+	tcp := v1.ProtocolTCP
+	port := intstr.FromInt(8080)
+
+	cases := []struct {
+		Input          api.NetworkPolicySpec
+		ExpectedOutput []interface{}
+	}{
+		{
+			api.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				PolicyTypes: []api.PolicyType{api.PolicyTypeIngress},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"pod_selector": []interface{}{map[string]interface{}{}},
+					"policy_types": []interface{}{"Ingress"},
+				},
+			},
+		},
+		{
+			api.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+				Ingress: []api.NetworkPolicyIngressRule{
+					{
+						Ports: []api.NetworkPolicyPort{{Port: &port, Protocol: &tcp}},
+						From: []api.NetworkPolicyPeer{
+							{NamespaceSelector: &metav1.LabelSelector{}},
+							{IPBlock: &api.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.0.1.0/24"}}},
+						},
+					},
+				},
+				Egress:      []api.NetworkPolicyEgressRule{{}},
+				PolicyTypes: []api.PolicyType{api.PolicyTypeIngress, api.PolicyTypeEgress},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"pod_selector": []interface{}{
+						map[string]interface{}{
+							"match_labels": map[string]string{"app": "api"},
+						},
+					},
+					"ingress": []interface{}{
+						map[string]interface{}{
+							"ports": []interface{}{
+								map[string]interface{}{"port": "8080", "protocol": "TCP"},
+							},
+							"from": []interface{}{
+								map[string]interface{}{
+									"namespace_selector": []interface{}{map[string]interface{}{}},
+								},
+								map[string]interface{}{
+									"ip_block": []interface{}{
+										map[string]interface{}{
+											"cidr":   "10.0.0.0/8",
+											"except": []string{"10.0.1.0/24"},
+										},
+									},
+								},
+							},
+						},
+					},
+					"egress":       []interface{}{map[string]interface{}{}},
+					"policy_types": []interface{}{"Ingress", "Egress"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenNetworkPolicySpec(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandNetworkPolicySpec(t *testing.T) {
+	tcp := v1.ProtocolTCP
+	udp := v1.ProtocolUDP
+	port := intstr.FromInt(53)
+	namedPort := intstr.FromString("metrics")
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput api.NetworkPolicySpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"pod_selector": []interface{}{map[string]interface{}{}},
+					"policy_types": []interface{}{"Ingress"},
+				},
+			},
+			api.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				PolicyTypes: []api.PolicyType{api.PolicyTypeIngress},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"pod_selector": []interface{}{
+						map[string]interface{}{
+							"match_labels": map[string]interface{}{"app": "api"},
+						},
+					},
+					"egress": []interface{}{
+						map[string]interface{}{
+							"ports": []interface{}{
+								map[string]interface{}{"port": "53", "protocol": "UDP"},
+								map[string]interface{}{"port": "metrics", "protocol": "TCP"},
+							},
+							"to": []interface{}{
+								map[string]interface{}{
+									"pod_selector": []interface{}{
+										map[string]interface{}{
+											"match_labels": map[string]interface{}{"app": "dns"},
+										},
+									},
+								},
+							},
+						},
+					},
+					"policy_types": []interface{}{"Egress"},
+				},
+			},
+			api.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+				Egress: []api.NetworkPolicyEgressRule{
+					{
+						Ports: []api.NetworkPolicyPort{
+							{Port: &port, Protocol: &udp},
+							{Port: &namedPort, Protocol: &tcp},
+						},
+						To: []api.NetworkPolicyPeer{
+							{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "dns"}}},
+						},
+					},
+				},
+				PolicyTypes: []api.PolicyType{api.PolicyTypeEgress},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandNetworkPolicySpec(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
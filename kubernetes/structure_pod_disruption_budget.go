@@ -0,0 +1,78 @@
+package kubernetes
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Flatteners
+
+func flattenPodDisruptionBudgetSpec(in api.PodDisruptionBudgetSpec) []interface{} {
+	att := make(map[string]interface{})
+	if in.MaxUnavailable != nil {
+		att["max_unavailable"] = in.MaxUnavailable.String()
+	}
+	if in.MinAvailable != nil {
+		att["min_available"] = in.MinAvailable.String()
+	}
+	if in.Selector != nil {
+		att["selector"] = flattenLabelSelector(in.Selector)
+	}
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandPodDisruptionBudgetSpec(l []interface{}) (api.PodDisruptionBudgetSpec, error) {
+	obj := api.PodDisruptionBudgetSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["max_unavailable"].(string); ok && v != "" {
+		val := intstr.Parse(v)
+		obj.MaxUnavailable = &val
+	}
+	if v, ok := in["min_available"].(string); ok && v != "" {
+		val := intstr.Parse(v)
+		obj.MinAvailable = &val
+	}
+	if obj.MaxUnavailable == nil && obj.MinAvailable == nil {
+		return obj, errors.New("One of min_available or max_unavailable must be set")
+	}
+	if v, ok := in["selector"].([]interface{}); ok && len(v) > 0 {
+		obj.Selector = expandLabelSelector(v)
+	}
+	return obj, nil
+}
+
+// Patchers
+
+func patchPodDisruptionBudgetSpec(pathPrefix, prefix string, d *schema.ResourceData) PatchOperations {
+	ops := make([]PatchOperation, 0)
+
+	fields := [][2]string{
+		{"max_unavailable", "maxUnavailable"},
+		{"min_available", "minAvailable"},
+	}
+	for _, f := range fields {
+		field, path := f[0], pathPrefix+"/"+f[1]
+		if !d.HasChange(prefix + field) {
+			continue
+		}
+		oldV, newV := d.GetChange(prefix + field)
+		switch {
+		case newV.(string) == "":
+			ops = append(ops, &RemoveOperation{Path: path})
+		case oldV.(string) == "":
+			ops = append(ops, &AddOperation{Path: path, Value: intstr.Parse(newV.(string))})
+		default:
+			ops = append(ops, &ReplaceOperation{Path: path, Value: intstr.Parse(newV.(string))})
+		}
+	}
+	return ops
+}
@@ -0,0 +1,77 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	api "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestExpandPodDisruptionBudgetSpec(t *testing.T) {
+	two := intstr.FromInt(2)
+	half := intstr.FromString("50%")
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput api.PodDisruptionBudgetSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"min_available": "2",
+					"selector": []interface{}{
+						map[string]interface{}{
+							"match_labels": map[string]interface{}{"app": "api"},
+						},
+					},
+				},
+			},
+			api.PodDisruptionBudgetSpec{
+				MinAvailable: &two,
+				Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"max_unavailable": "50%",
+					"min_available":   "",
+				},
+			},
+			api.PodDisruptionBudgetSpec{
+				MaxUnavailable: &half,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := expandPodDisruptionBudgetSpec(tc.Input)
+		if err != nil {
+			t.Fatalf("Unexpected error from expander: %s", err)
+		}
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+		flattened := flattenPodDisruptionBudgetSpec(output)[0].(map[string]interface{})
+		for _, k := range []string{"max_unavailable", "min_available"} {
+			expected := tc.Input[0].(map[string]interface{})[k]
+			if expected == nil {
+				expected = ""
+			}
+			given, _ := flattened[k].(string)
+			if given != expected {
+				t.Fatalf("Unexpected %s from flattener.\nExpected: %#v\nGiven:    %#v", k, expected, given)
+			}
+		}
+	}
+
+	_, err := expandPodDisruptionBudgetSpec([]interface{}{
+		map[string]interface{}{"min_available": "", "max_unavailable": ""},
+	})
+	if err == nil {
+		t.Fatal("Expected an error when neither min_available nor max_unavailable is set")
+	}
+}
@@ -0,0 +1,248 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/api/core/v1"
+	api "k8s.io/api/extensions/v1beta1"
+)
+
+// Flatteners
+
+func flattenPodSecurityPolicySpec(in api.PodSecurityPolicySpec) []interface{} {
+	att := make(map[string]interface{})
+
+	att["allow_privilege_escalation"] = in.AllowPrivilegeEscalation == nil || *in.AllowPrivilegeEscalation
+	if len(in.AllowedCapabilities) > 0 {
+		att["allowed_capabilities"] = flattenCapabilities(in.AllowedCapabilities)
+	}
+	if len(in.AllowedFlexVolumes) > 0 {
+		volumes := make([]interface{}, len(in.AllowedFlexVolumes))
+		for i, v := range in.AllowedFlexVolumes {
+			volumes[i] = map[string]interface{}{"driver": v.Driver}
+		}
+		att["allowed_flex_volumes"] = volumes
+	}
+	if len(in.AllowedHostPaths) > 0 {
+		paths := make([]interface{}, len(in.AllowedHostPaths))
+		for i, p := range in.AllowedHostPaths {
+			paths[i] = map[string]interface{}{"path_prefix": p.PathPrefix}
+		}
+		att["allowed_host_paths"] = paths
+	}
+	if len(in.DefaultAddCapabilities) > 0 {
+		att["default_add_capabilities"] = flattenCapabilities(in.DefaultAddCapabilities)
+	}
+	att["default_allow_privilege_escalation"] = in.DefaultAllowPrivilegeEscalation != nil && *in.DefaultAllowPrivilegeEscalation
+	att["fs_group"] = flattenIDRangeStrategy(string(in.FSGroup.Rule), in.FSGroup.Ranges)
+	att["host_ipc"] = in.HostIPC
+	att["host_network"] = in.HostNetwork
+	att["host_pid"] = in.HostPID
+	if len(in.HostPorts) > 0 {
+		ports := make([]interface{}, len(in.HostPorts))
+		for i, p := range in.HostPorts {
+			ports[i] = map[string]interface{}{
+				"max": int(p.Max),
+				"min": int(p.Min),
+			}
+		}
+		att["host_ports"] = ports
+	}
+	att["privileged"] = in.Privileged
+	att["read_only_root_filesystem"] = in.ReadOnlyRootFilesystem
+	if len(in.RequiredDropCapabilities) > 0 {
+		att["required_drop_capabilities"] = flattenCapabilities(in.RequiredDropCapabilities)
+	}
+	att["run_as_user"] = flattenIDRangeStrategy(string(in.RunAsUser.Rule), in.RunAsUser.Ranges)
+	att["se_linux"] = flattenSELinuxStrategy(in.SELinux)
+	att["supplemental_groups"] = flattenIDRangeStrategy(string(in.SupplementalGroups.Rule), in.SupplementalGroups.Ranges)
+	if len(in.Volumes) > 0 {
+		volumes := make([]string, len(in.Volumes))
+		for i, v := range in.Volumes {
+			volumes[i] = string(v)
+		}
+		att["volumes"] = newStringSet(schema.HashString, volumes)
+	}
+
+	return []interface{}{att}
+}
+
+func flattenCapabilities(in []v1.Capability) *schema.Set {
+	capabilities := make([]string, len(in))
+	for i, c := range in {
+		capabilities[i] = string(c)
+	}
+	return newStringSet(schema.HashString, capabilities)
+}
+
+func flattenIDRangeStrategy(rule string, in []api.IDRange) []interface{} {
+	att := map[string]interface{}{
+		"rule": rule,
+	}
+	if len(in) > 0 {
+		ranges := make([]interface{}, len(in))
+		for i, r := range in {
+			ranges[i] = map[string]interface{}{
+				"max": int(r.Max),
+				"min": int(r.Min),
+			}
+		}
+		att["range"] = ranges
+	}
+	return []interface{}{att}
+}
+
+func flattenSELinuxStrategy(in api.SELinuxStrategyOptions) []interface{} {
+	att := map[string]interface{}{
+		"rule": string(in.Rule),
+	}
+	if in.SELinuxOptions != nil {
+		att["se_linux_options"] = flattenSeLinuxOptions(in.SELinuxOptions)
+	}
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandPodSecurityPolicySpec(l []interface{}) api.PodSecurityPolicySpec {
+	obj := api.PodSecurityPolicySpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["allow_privilege_escalation"].(bool); ok {
+		obj.AllowPrivilegeEscalation = ptrToBool(v)
+	}
+	if v, ok := in["allowed_capabilities"].(*schema.Set); ok {
+		obj.AllowedCapabilities = expandCapabilities(v)
+	}
+	if v, ok := in["allowed_flex_volumes"].([]interface{}); ok && len(v) > 0 {
+		obj.AllowedFlexVolumes = make([]api.AllowedFlexVolume, len(v))
+		for i, f := range v {
+			obj.AllowedFlexVolumes[i] = api.AllowedFlexVolume{
+				Driver: f.(map[string]interface{})["driver"].(string),
+			}
+		}
+	}
+	if v, ok := in["allowed_host_paths"].([]interface{}); ok && len(v) > 0 {
+		obj.AllowedHostPaths = make([]api.AllowedHostPath, len(v))
+		for i, p := range v {
+			obj.AllowedHostPaths[i] = api.AllowedHostPath{
+				PathPrefix: p.(map[string]interface{})["path_prefix"].(string),
+			}
+		}
+	}
+	if v, ok := in["default_add_capabilities"].(*schema.Set); ok {
+		obj.DefaultAddCapabilities = expandCapabilities(v)
+	}
+	if v, ok := in["default_allow_privilege_escalation"].(bool); ok {
+		obj.DefaultAllowPrivilegeEscalation = ptrToBool(v)
+	}
+	if v, ok := in["fs_group"].([]interface{}); ok {
+		rule, ranges := expandIDRangeStrategy(v)
+		obj.FSGroup = api.FSGroupStrategyOptions{
+			Rule:   api.FSGroupStrategyType(rule),
+			Ranges: ranges,
+		}
+	}
+	if v, ok := in["host_ipc"].(bool); ok {
+		obj.HostIPC = v
+	}
+	if v, ok := in["host_network"].(bool); ok {
+		obj.HostNetwork = v
+	}
+	if v, ok := in["host_pid"].(bool); ok {
+		obj.HostPID = v
+	}
+	if v, ok := in["host_ports"].([]interface{}); ok && len(v) > 0 {
+		obj.HostPorts = make([]api.HostPortRange, len(v))
+		for i, p := range v {
+			m := p.(map[string]interface{})
+			obj.HostPorts[i] = api.HostPortRange{
+				Max: int32(m["max"].(int)),
+				Min: int32(m["min"].(int)),
+			}
+		}
+	}
+	if v, ok := in["privileged"].(bool); ok {
+		obj.Privileged = v
+	}
+	if v, ok := in["read_only_root_filesystem"].(bool); ok {
+		obj.ReadOnlyRootFilesystem = v
+	}
+	if v, ok := in["required_drop_capabilities"].(*schema.Set); ok {
+		obj.RequiredDropCapabilities = expandCapabilities(v)
+	}
+	if v, ok := in["run_as_user"].([]interface{}); ok {
+		rule, ranges := expandIDRangeStrategy(v)
+		obj.RunAsUser = api.RunAsUserStrategyOptions{
+			Rule:   api.RunAsUserStrategy(rule),
+			Ranges: ranges,
+		}
+	}
+	if v, ok := in["se_linux"].([]interface{}); ok {
+		obj.SELinux = expandSELinuxStrategy(v)
+	}
+	if v, ok := in["supplemental_groups"].([]interface{}); ok {
+		rule, ranges := expandIDRangeStrategy(v)
+		obj.SupplementalGroups = api.SupplementalGroupsStrategyOptions{
+			Rule:   api.SupplementalGroupsStrategyType(rule),
+			Ranges: ranges,
+		}
+	}
+	if v, ok := in["volumes"].(*schema.Set); ok && v.Len() > 0 {
+		volumes := schemaSetToStringArray(v)
+		obj.Volumes = make([]api.FSType, len(volumes))
+		for i, v := range volumes {
+			obj.Volumes[i] = api.FSType(v)
+		}
+	}
+
+	return obj
+}
+
+func expandCapabilities(in *schema.Set) []v1.Capability {
+	if in.Len() == 0 {
+		return nil
+	}
+	capabilities := schemaSetToStringArray(in)
+	out := make([]v1.Capability, len(capabilities))
+	for i, c := range capabilities {
+		out[i] = v1.Capability(c)
+	}
+	return out
+}
+
+func expandIDRangeStrategy(l []interface{}) (string, []api.IDRange) {
+	if len(l) == 0 || l[0] == nil {
+		return "", nil
+	}
+	in := l[0].(map[string]interface{})
+
+	var ranges []api.IDRange
+	if v, ok := in["range"].([]interface{}); ok && len(v) > 0 {
+		ranges = make([]api.IDRange, len(v))
+		for i, r := range v {
+			m := r.(map[string]interface{})
+			ranges[i] = api.IDRange{
+				Max: int64(m["max"].(int)),
+				Min: int64(m["min"].(int)),
+			}
+		}
+	}
+	return in["rule"].(string), ranges
+}
+
+func expandSELinuxStrategy(l []interface{}) api.SELinuxStrategyOptions {
+	obj := api.SELinuxStrategyOptions{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.Rule = api.SELinuxStrategy(in["rule"].(string))
+	if v, ok := in["se_linux_options"].([]interface{}); ok && len(v) > 0 {
+		obj.SELinuxOptions = expandSeLinuxOptions(v)
+	}
+	return obj
+}
@@ -0,0 +1,60 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	api "k8s.io/api/extensions/v1beta1"
+)
+
+func TestPodSecurityPolicySpecRoundTrip(t *testing.T) {
+	cases := []api.PodSecurityPolicySpec{
+		{
+			AllowPrivilegeEscalation:        ptrToBool(false),
+			DefaultAllowPrivilegeEscalation: ptrToBool(false),
+			RequiredDropCapabilities:        []v1.Capability{"ALL"},
+			Volumes:                         []api.FSType{api.ConfigMap},
+			HostPorts:                       []api.HostPortRange{{Min: 8000, Max: 8080}},
+			ReadOnlyRootFilesystem:          true,
+			SELinux:                         api.SELinuxStrategyOptions{Rule: api.SELinuxStrategyRunAsAny},
+			RunAsUser:                       api.RunAsUserStrategyOptions{Rule: api.RunAsUserStrategyMustRunAsNonRoot},
+			SupplementalGroups: api.SupplementalGroupsStrategyOptions{
+				Rule:   api.SupplementalGroupsStrategyMustRunAs,
+				Ranges: []api.IDRange{{Min: 1, Max: 65535}},
+			},
+			FSGroup: api.FSGroupStrategyOptions{
+				Rule:   api.FSGroupStrategyMustRunAs,
+				Ranges: []api.IDRange{{Min: 1, Max: 100}, {Min: 1000, Max: 2000}},
+			},
+			AllowedHostPaths:   []api.AllowedHostPath{{PathPrefix: "/var/log"}},
+			AllowedFlexVolumes: []api.AllowedFlexVolume{{Driver: "example/lvm"}},
+		},
+		{
+			AllowPrivilegeEscalation:        ptrToBool(true),
+			DefaultAllowPrivilegeEscalation: ptrToBool(false),
+			Privileged:                      true,
+			HostNetwork:                     true,
+			HostPID:                         true,
+			HostIPC:                         true,
+			AllowedCapabilities:             []v1.Capability{"*"},
+			DefaultAddCapabilities:          []v1.Capability{"NET_ADMIN"},
+			Volumes:                         []api.FSType{api.All},
+			SELinux: api.SELinuxStrategyOptions{
+				Rule:           api.SELinuxStrategyMustRunAs,
+				SELinuxOptions: &v1.SELinuxOptions{Type: "spc_t", Level: "s0"},
+			},
+			RunAsUser:          api.RunAsUserStrategyOptions{Rule: api.RunAsUserStrategyRunAsAny},
+			SupplementalGroups: api.SupplementalGroupsStrategyOptions{Rule: api.SupplementalGroupsStrategyRunAsAny},
+			FSGroup:            api.FSGroupStrategyOptions{Rule: api.FSGroupStrategyRunAsAny},
+		},
+	}
+
+	for _, tc := range cases {
+		d := testStateData(t, resourceKubernetesPodSecurityPolicy().Schema, "spec", flattenPodSecurityPolicySpec(tc))
+		output := expandPodSecurityPolicySpec(d.Get("spec").([]interface{}))
+		if !reflect.DeepEqual(output, tc) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", tc, output)
+		}
+	}
+}
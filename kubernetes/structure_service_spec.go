@@ -54,6 +54,28 @@ func flattenServiceSpec(in v1.ServiceSpec) []interface{} {
 	if in.ExternalName != "" {
 		att["external_name"] = in.ExternalName
 	}
+	if in.ExternalTrafficPolicy != "" {
+		att["external_traffic_policy"] = string(in.ExternalTrafficPolicy)
+	}
+	if in.HealthCheckNodePort != 0 {
+		att["health_check_node_port"] = int(in.HealthCheckNodePort)
+	}
+	att["publish_not_ready_addresses"] = in.PublishNotReadyAddresses
+	if in.SessionAffinityConfig != nil {
+		att["session_affinity_config"] = flattenSessionAffinityConfig(in.SessionAffinityConfig)
+	}
+	return []interface{}{att}
+}
+
+func flattenSessionAffinityConfig(in *v1.SessionAffinityConfig) []interface{} {
+	att := make(map[string]interface{})
+	if in.ClientIP != nil {
+		clientIP := make(map[string]interface{})
+		if in.ClientIP.TimeoutSeconds != nil {
+			clientIP["timeout_seconds"] = int(*in.ClientIP.TimeoutSeconds)
+		}
+		att["client_ip"] = []interface{}{clientIP}
+	}
 	return []interface{}{att}
 }
 
@@ -130,6 +152,36 @@ func expandServiceSpec(l []interface{}) v1.ServiceSpec {
 	if v, ok := in["external_name"].(string); ok {
 		obj.ExternalName = v
 	}
+	if v, ok := in["external_traffic_policy"].(string); ok {
+		obj.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicyType(v)
+	}
+	if v, ok := in["health_check_node_port"].(int); ok {
+		obj.HealthCheckNodePort = int32(v)
+	}
+	if v, ok := in["publish_not_ready_addresses"].(bool); ok {
+		obj.PublishNotReadyAddresses = v
+	}
+	if v, ok := in["session_affinity_config"].([]interface{}); ok && len(v) > 0 {
+		obj.SessionAffinityConfig = expandSessionAffinityConfig(v)
+	}
+	return obj
+}
+
+func expandSessionAffinityConfig(l []interface{}) *v1.SessionAffinityConfig {
+	obj := &v1.SessionAffinityConfig{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	if v, ok := in["client_ip"].([]interface{}); ok && len(v) > 0 {
+		obj.ClientIP = &v1.ClientIPConfig{}
+		if v[0] != nil {
+			cfg := v[0].(map[string]interface{})
+			if t, ok := cfg["timeout_seconds"].(int); ok && t > 0 {
+				obj.ClientIP.TimeoutSeconds = ptrToInt32(int32(t))
+			}
+		}
+	}
 	return obj
 }
 
@@ -198,5 +250,40 @@ func patchServiceSpec(keyPrefix, pathPrefix string, d *schema.ResourceData, v *v
 			Value: d.Get(keyPrefix + "external_name").(string),
 		})
 	}
+	// The fields below are omitted from the API objects when empty,
+	// so they have to be added rather than replaced
+	if d.HasChange(keyPrefix + "external_traffic_policy") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "externalTrafficPolicy",
+			Value: d.Get(keyPrefix + "external_traffic_policy").(string),
+		})
+	}
+	if d.HasChange(keyPrefix+"health_check_node_port") && d.Get(keyPrefix+"health_check_node_port").(int) != 0 {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "healthCheckNodePort",
+			Value: d.Get(keyPrefix + "health_check_node_port").(int),
+		})
+	}
+	if d.HasChange(keyPrefix + "publish_not_ready_addresses") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "publishNotReadyAddresses",
+			Value: d.Get(keyPrefix + "publish_not_ready_addresses").(bool),
+		})
+	}
+	if d.HasChange(keyPrefix+"session_affinity") || d.HasChange(keyPrefix+"session_affinity_config") {
+		o, _ := d.GetChange(keyPrefix + "session_affinity_config")
+		if d.Get(keyPrefix+"session_affinity").(string) == string(v1.ServiceAffinityNone) {
+			// The API rejects any session affinity config when the
+			// session affinity is None
+			if len(o.([]interface{})) > 0 {
+				ops = append(ops, &RemoveOperation{Path: pathPrefix + "sessionAffinityConfig"})
+			}
+		} else if v, ok := d.GetOk(keyPrefix + "session_affinity_config"); ok {
+			ops = append(ops, &AddOperation{
+				Path:  pathPrefix + "sessionAffinityConfig",
+				Value: expandSessionAffinityConfig(v.([]interface{})),
+			})
+		}
+	}
 	return ops, nil
 }
@@ -0,0 +1,45 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestServiceSpecRoundTrip(t *testing.T) {
+	cases := []v1.ServiceSpec{
+		{
+			Ports: []v1.ServicePort{
+				{Name: "http", Protocol: v1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt(8080), NodePort: 30080},
+			},
+			Selector:              map[string]string{"app": "web"},
+			Type:                  v1.ServiceTypeLoadBalancer,
+			SessionAffinity:       v1.ServiceAffinityClientIP,
+			ExternalTrafficPolicy: v1.ServiceExternalTrafficPolicyTypeLocal,
+			HealthCheckNodePort:   31000,
+			SessionAffinityConfig: &v1.SessionAffinityConfig{
+				ClientIP: &v1.ClientIPConfig{TimeoutSeconds: ptrToInt32(600)},
+			},
+		},
+		{
+			Ports: []v1.ServicePort{
+				{Name: "peer", Protocol: v1.ProtocolTCP, Port: 2380, TargetPort: intstr.FromString("peer")},
+			},
+			Selector:                 map[string]string{"app": "etcd"},
+			ClusterIP:                "None",
+			Type:                     v1.ServiceTypeClusterIP,
+			SessionAffinity:          v1.ServiceAffinityNone,
+			PublishNotReadyAddresses: true,
+		},
+	}
+
+	for _, tc := range cases {
+		d := testStateData(t, resourceKubernetesService().Schema, "spec", flattenServiceSpec(tc))
+		output := expandServiceSpec(d.Get("spec").([]interface{}))
+		if !reflect.DeepEqual(output, tc) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", tc, output)
+		}
+	}
+}
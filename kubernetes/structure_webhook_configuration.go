@@ -0,0 +1,137 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/admissionregistration/v1beta1"
+)
+
+// Flatteners
+
+func flattenWebhooks(in []api.Webhook) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, w := range in {
+		m := map[string]interface{}{
+			"client_config": flattenWebhookClientConfig(w.ClientConfig),
+			"name":          w.Name,
+		}
+		if w.FailurePolicy != nil {
+			m["failure_policy"] = string(*w.FailurePolicy)
+		} else {
+			m["failure_policy"] = string(api.Ignore)
+		}
+		if w.NamespaceSelector != nil {
+			m["namespace_selector"] = flattenLabelSelector(w.NamespaceSelector)
+		}
+		if len(w.Rules) > 0 {
+			m["rule"] = flattenWebhookRules(w.Rules)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenWebhookClientConfig(in api.WebhookClientConfig) []interface{} {
+	// The bundle is kept decoded, like the data of a secret, []byte
+	// fields are base64 encoded when marshalled to JSON
+	att := map[string]interface{}{
+		"ca_bundle": string(in.CABundle),
+	}
+	if in.Service != nil {
+		service := map[string]interface{}{
+			"name":      in.Service.Name,
+			"namespace": in.Service.Namespace,
+		}
+		if in.Service.Path != nil {
+			service["path"] = *in.Service.Path
+		}
+		att["service"] = []interface{}{service}
+	}
+	if in.URL != nil {
+		att["url"] = *in.URL
+	}
+	return []interface{}{att}
+}
+
+func flattenWebhookRules(in []api.RuleWithOperations) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, r := range in {
+		operations := make([]string, len(r.Operations))
+		for j, o := range r.Operations {
+			operations[j] = string(o)
+		}
+		att[i] = map[string]interface{}{
+			"api_groups":   newStringSet(schema.HashString, r.APIGroups),
+			"api_versions": newStringSet(schema.HashString, r.APIVersions),
+			"operations":   newStringSet(schema.HashString, operations),
+			"resources":    newStringSet(schema.HashString, r.Resources),
+		}
+	}
+	return att
+}
+
+// Expanders
+
+func expandWebhooks(l []interface{}) []api.Webhook {
+	obj := make([]api.Webhook, len(l))
+	for i, w := range l {
+		in := w.(map[string]interface{})
+		obj[i] = api.Webhook{
+			Name:              in["name"].(string),
+			ClientConfig:      expandWebhookClientConfig(in["client_config"].([]interface{})),
+			NamespaceSelector: expandLabelSelector(in["namespace_selector"].([]interface{})),
+		}
+		if v, ok := in["failure_policy"].(string); ok && v != "" {
+			policy := api.FailurePolicyType(v)
+			obj[i].FailurePolicy = &policy
+		}
+		if v, ok := in["rule"].([]interface{}); ok && len(v) > 0 {
+			obj[i].Rules = expandWebhookRules(v)
+		}
+	}
+	return obj
+}
+
+func expandWebhookClientConfig(l []interface{}) api.WebhookClientConfig {
+	obj := api.WebhookClientConfig{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	if v, ok := in["ca_bundle"].(string); ok && v != "" {
+		obj.CABundle = []byte(v)
+	}
+	if v, ok := in["service"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		service := v[0].(map[string]interface{})
+		obj.Service = &api.ServiceReference{
+			Name:      service["name"].(string),
+			Namespace: service["namespace"].(string),
+		}
+		if p, ok := service["path"].(string); ok && p != "" {
+			obj.Service.Path = ptrToString(p)
+		}
+	}
+	if v, ok := in["url"].(string); ok && v != "" {
+		obj.URL = ptrToString(v)
+	}
+	return obj
+}
+
+func expandWebhookRules(l []interface{}) []api.RuleWithOperations {
+	obj := make([]api.RuleWithOperations, len(l))
+	for i, r := range l {
+		in := r.(map[string]interface{})
+		operations := schemaSetToStringArray(in["operations"].(*schema.Set))
+		obj[i] = api.RuleWithOperations{
+			Rule: api.Rule{
+				APIGroups:   schemaSetToStringArray(in["api_groups"].(*schema.Set)),
+				APIVersions: schemaSetToStringArray(in["api_versions"].(*schema.Set)),
+				Resources:   schemaSetToStringArray(in["resources"].(*schema.Set)),
+			},
+			Operations: make([]api.OperationType, len(operations)),
+		}
+		for j, o := range operations {
+			obj[i].Operations[j] = api.OperationType(o)
+		}
+	}
+	return obj
+}
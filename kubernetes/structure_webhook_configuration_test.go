@@ -0,0 +1,83 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	api "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWebhooksRoundTrip(t *testing.T) {
+	fail := api.Fail
+	ignore := api.Ignore
+	cases := [][]api.Webhook{
+		{
+			{
+				Name: "pods.policy.example.com",
+				ClientConfig: api.WebhookClientConfig{
+					Service: &api.ServiceReference{
+						Namespace: "policy",
+						Name:      "webhook",
+						Path:      ptrToString("/validate"),
+					},
+					CABundle: []byte("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"),
+				},
+				Rules: []api.RuleWithOperations{
+					{
+						Operations: []api.OperationType{api.Create},
+						Rule: api.Rule{
+							APIGroups:   []string{""},
+							APIVersions: []string{"v1"},
+							Resources:   []string{"pods"},
+						},
+					},
+				},
+				FailurePolicy: &fail,
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "policy", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"disabled"}},
+					},
+				},
+			},
+		},
+		{
+			{
+				Name: "external.example.com",
+				ClientConfig: api.WebhookClientConfig{
+					URL: ptrToString("https://webhook.example.com/mutate"),
+				},
+				FailurePolicy:     &ignore,
+				NamespaceSelector: &metav1.LabelSelector{},
+			},
+			{
+				Name: "labels.example.com",
+				ClientConfig: api.WebhookClientConfig{
+					Service: &api.ServiceReference{Namespace: "default", Name: "labels"},
+				},
+				Rules: []api.RuleWithOperations{
+					{
+						Operations: []api.OperationType{api.OperationAll},
+						Rule: api.Rule{
+							APIGroups:   []string{"apps"},
+							APIVersions: []string{"*"},
+							Resources:   []string{"deployments/*"},
+						},
+					},
+				},
+				FailurePolicy: &ignore,
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"labels": "enabled"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		d := testStateData(t, resourceKubernetesValidatingWebhookConfiguration().Schema, "webhook", flattenWebhooks(tc))
+		output := expandWebhooks(d.Get("webhook").([]interface{}))
+		if !reflect.DeepEqual(output, tc) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", tc, output)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -26,15 +27,15 @@ func buildId(meta metav1.ObjectMeta) string {
 	return meta.Namespace + "/" + meta.Name
 }
 
-func expandMetadata(in []interface{}) metav1.ObjectMeta {
+func expandMetadata(in []interface{}, config *metadataConfig) metav1.ObjectMeta {
 	meta := metav1.ObjectMeta{}
 	if len(in) < 1 {
 		return meta
 	}
 	m := in[0].(map[string]interface{})
 
-	meta.Annotations = expandStringMap(m["annotations"].(map[string]interface{}))
-	meta.Labels = expandStringMap(m["labels"].(map[string]interface{}))
+	meta.Annotations = withDefaults(expandStringMap(m["annotations"].(map[string]interface{})), config.defaultAnnotations)
+	meta.Labels = withDefaults(expandStringMap(m["labels"].(map[string]interface{})), config.defaultLabels)
 
 	if v, ok := m["generate_name"]; ok {
 		meta.GenerateName = v.(string)
@@ -43,27 +44,53 @@ func expandMetadata(in []interface{}) metav1.ObjectMeta {
 		meta.Name = v.(string)
 	}
 	if v, ok := m["namespace"]; ok {
-		meta.Namespace = v.(string)
+		meta.Namespace = namespaceOrDefault(v.(string), config)
 	}
 
 	return meta
 }
 
-func patchMetadata(keyPrefix, pathPrefix string, d *schema.ResourceData) PatchOperations {
+func patchMetadata(keyPrefix, pathPrefix string, d *schema.ResourceData, meta interface{}) PatchOperations {
+	config := meta.(*providerMeta).metadata
+
 	ops := make([]PatchOperation, 0, 0)
 	if d.HasChange(keyPrefix + "annotations") {
 		oldV, newV := d.GetChange(keyPrefix + "annotations")
-		diffOps := diffStringMap(pathPrefix+"annotations", oldV.(map[string]interface{}), newV.(map[string]interface{}))
+		diffOps := diffMetadataMap(pathPrefix+"annotations", oldV.(map[string]interface{}), newV.(map[string]interface{}),
+			config.defaultAnnotations, config.ignoreAnnotations)
 		ops = append(ops, diffOps...)
 	}
 	if d.HasChange(keyPrefix + "labels") {
 		oldV, newV := d.GetChange(keyPrefix + "labels")
-		diffOps := diffStringMap(pathPrefix+"labels", oldV.(map[string]interface{}), newV.(map[string]interface{}))
+		diffOps := diffMetadataMap(pathPrefix+"labels", oldV.(map[string]interface{}), newV.(map[string]interface{}),
+			config.defaultLabels, config.ignoreLabels)
 		ops = append(ops, diffOps...)
 	}
 	return ops
 }
 
+// diffMetadataMap diffs the annotations or labels of the state with the
+// defaults of the provider merged in, leaving the ignored keys alone
+func diffMetadataMap(path string, oldV, newV map[string]interface{}, defaults map[string]string, rules []*regexp.Regexp) PatchOperations {
+	// Without keys in the state the object may not have any, so the
+	// defaults can't be assumed to be there already
+	if len(oldV) > 0 {
+		oldV = withStateDefaults(oldV, defaults)
+	}
+	newV = withStateDefaults(newV, defaults)
+	oldV, newV = withoutIgnoredKeys(oldV, rules), withoutIgnoredKeys(newV, rules)
+
+	if len(oldV) == 0 {
+		if len(newV) == 0 {
+			return PatchOperations{}
+		}
+		// The object may still have a map holding ignored keys, see
+		// sendJSONPatch
+		return PatchOperations{&AddMapOperation{Path: path, Value: newV}}
+	}
+	return diffStringMapKeys(path, oldV, newV)
+}
+
 func expandStringMap(m map[string]interface{}) map[string]string {
 	result := make(map[string]string)
 	for k, v := range m {
@@ -88,13 +115,23 @@ func expandStringSlice(s []interface{}) []string {
 	return result
 }
 
-func flattenMetadata(meta metav1.ObjectMeta) []map[string]interface{} {
+// flattenMetadata flattens the metadata found at the given prefix of d, e.g.
+// spec.0.template.0., the keys set there are kept even if they have their
+// default value. d is nil when the metadata isn't part of the resource.
+func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, config *metadataConfig, metaPrefix ...string) []map[string]interface{} {
+	var annotations, labels map[string]interface{}
+	if d != nil {
+		prefix := strings.Join(metaPrefix, "") + "metadata.0."
+		annotations, _ = d.Get(prefix + "annotations").(map[string]interface{})
+		labels, _ = d.Get(prefix + "labels").(map[string]interface{})
+	}
+
 	m := make(map[string]interface{})
-	m["annotations"] = removeInternalKeys(meta.Annotations)
+	m["annotations"] = removeDefaultKeys(removeIgnoredKeys(removeInternalKeys(meta.Annotations), config.ignoreAnnotations), config.defaultAnnotations, annotations)
 	if meta.GenerateName != "" {
 		m["generate_name"] = meta.GenerateName
 	}
-	m["labels"] = removeInternalKeys(meta.Labels)
+	m["labels"] = removeDefaultKeys(removeIgnoredKeys(removeInternalKeys(meta.Labels), config.ignoreLabels), config.defaultLabels, labels)
 	m["name"] = meta.Name
 	m["resource_version"] = meta.ResourceVersion
 	m["self_link"] = meta.SelfLink
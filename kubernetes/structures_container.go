@@ -1,8 +1,10 @@
 package kubernetes
 
 import (
+	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform/helper/schema"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -18,6 +20,9 @@ func flattenCapability(in []v1.Capability) []string {
 func flattenContainerSecurityContext(in *v1.SecurityContext) []interface{} {
 	att := make(map[string]interface{})
 
+	if in.AllowPrivilegeEscalation != nil {
+		att["allow_privilege_escalation"] = *in.AllowPrivilegeEscalation
+	}
 	if in.Privileged != nil {
 		att["privileged"] = *in.Privileged
 	}
@@ -252,11 +257,25 @@ func flattenContainerVolumeMounts(in []v1.VolumeMount) ([]interface{}, error) {
 		if v.SubPath != "" {
 			m["sub_path"] = v.SubPath
 		}
+		if v.MountPropagation != nil {
+			m["mount_propagation"] = string(*v.MountPropagation)
+		}
 		att[i] = m
 	}
 	return att, nil
 }
 
+func flattenContainerVolumeDevices(in []v1.VolumeDevice) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		m := map[string]interface{}{}
+		m["device_path"] = v.DevicePath
+		m["name"] = v.Name
+		att[i] = m
+	}
+	return att
+}
+
 func flattenContainerEnvs(in []v1.EnvVar) []interface{} {
 	att := make([]interface{}, len(in))
 	for i, v := range in {
@@ -276,6 +295,33 @@ func flattenContainerEnvs(in []v1.EnvVar) []interface{} {
 	return att
 }
 
+func flattenContainerEnvFroms(in []v1.EnvFromSource) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		m := map[string]interface{}{}
+		if v.ConfigMapRef != nil {
+			m["config_map_ref"] = flattenEnvFromSourceRef(v.ConfigMapRef.LocalObjectReference, v.ConfigMapRef.Optional)
+		}
+		if v.Prefix != "" {
+			m["prefix"] = v.Prefix
+		}
+		if v.SecretRef != nil {
+			m["secret_ref"] = flattenEnvFromSourceRef(v.SecretRef.LocalObjectReference, v.SecretRef.Optional)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenEnvFromSourceRef(ref v1.LocalObjectReference, optional *bool) []interface{} {
+	att := make(map[string]interface{})
+	att["name"] = ref.Name
+	if optional != nil {
+		att["optional"] = *optional
+	}
+	return []interface{}{att}
+}
+
 func flattenContainerPorts(in []v1.ContainerPort) []interface{} {
 	att := make([]interface{}, len(in))
 	for i, v := range in {
@@ -322,6 +368,7 @@ func flattenContainers(in []v1.Container) ([]interface{}, error) {
 
 		c["image_pull_policy"] = v.ImagePullPolicy
 		c["termination_message_path"] = v.TerminationMessagePath
+		c["termination_message_policy"] = string(v.TerminationMessagePolicy)
 		c["stdin"] = v.Stdin
 		c["stdin_once"] = v.StdinOnce
 		c["tty"] = v.TTY
@@ -351,7 +398,13 @@ func flattenContainers(in []v1.Container) ([]interface{}, error) {
 		if len(v.Env) > 0 {
 			c["env"] = flattenContainerEnvs(v.Env)
 		}
+		if len(v.EnvFrom) > 0 {
+			c["env_from"] = flattenContainerEnvFroms(v.EnvFrom)
+		}
 
+		if len(v.VolumeDevices) > 0 {
+			c["volume_device"] = flattenContainerVolumeDevices(v.VolumeDevices)
+		}
 		if len(v.VolumeMounts) > 0 {
 			volumeMounts, err := flattenContainerVolumeMounts(v.VolumeMounts)
 			if err != nil {
@@ -364,7 +417,9 @@ func flattenContainers(in []v1.Container) ([]interface{}, error) {
 	return att, nil
 }
 
-func expandContainers(ctrs []interface{}) ([]v1.Container, error) {
+// expandContainers expands the containers found at the given key prefix of
+// d, e.g. spec.0.container
+func expandContainers(ctrs []interface{}, d *schema.ResourceData, prefix string) ([]v1.Container, error) {
 	if len(ctrs) == 0 {
 		return []v1.Container{}, nil
 	}
@@ -409,6 +464,10 @@ func expandContainers(ctrs []interface{}) ([]v1.Container, error) {
 			}
 		}
 
+		if v, ok := ctr["env_from"].([]interface{}); ok && len(v) > 0 {
+			cs[i].EnvFrom = expandContainerEnvFrom(v)
+		}
+
 		if policy, ok := ctr["image_pull_policy"]; ok {
 			cs[i].ImagePullPolicy = v1.PullPolicy(policy.(string))
 		}
@@ -433,12 +492,29 @@ func expandContainers(ctrs []interface{}) ([]v1.Container, error) {
 		if v, ok := ctr["termination_message_path"]; ok {
 			cs[i].TerminationMessagePath = v.(string)
 		}
+		if v, ok := ctr["termination_message_policy"].(string); ok {
+			cs[i].TerminationMessagePolicy = v1.TerminationMessagePolicy(v)
+		}
 		if v, ok := ctr["tty"]; ok {
 			cs[i].TTY = v.(bool)
 		}
 		if v, ok := ctr["security_context"].([]interface{}); ok && len(v) > 0 {
 			cs[i].SecurityContext = expandContainerSecurityContext(v)
 		}
+		// An unset allow_privilege_escalation can't be told apart from false
+		// in ctr, it's only sent when set so that pod security policies may
+		// still default it
+		key := fmt.Sprintf("%s.%d.security_context.0.allow_privilege_escalation", prefix, i)
+		if v, ok := d.GetOkExists(key); ok {
+			if cs[i].SecurityContext == nil {
+				cs[i].SecurityContext = &v1.SecurityContext{}
+			}
+			cs[i].SecurityContext.AllowPrivilegeEscalation = ptrToBool(v.(bool))
+		}
+
+		if v, ok := ctr["volume_device"].([]interface{}); ok && len(v) > 0 {
+			cs[i].VolumeDevices = expandContainerVolumeDevices(v)
+		}
 
 		if v, ok := ctr["volume_mount"].([]interface{}); ok && len(v) > 0 {
 			var err error
@@ -654,10 +730,31 @@ func expandContainerVolumeMounts(in []interface{}) ([]v1.VolumeMount, error) {
 		if subPath, ok := p["sub_path"]; ok {
 			vmp[i].SubPath = subPath.(string)
 		}
+		if v, ok := p["mount_propagation"].(string); ok && v != "" {
+			mode := v1.MountPropagationMode(v)
+			vmp[i].MountPropagation = &mode
+		}
 	}
 	return vmp, nil
 }
 
+func expandContainerVolumeDevices(in []interface{}) []v1.VolumeDevice {
+	devices := make([]v1.VolumeDevice, len(in))
+	for i, c := range in {
+		p, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if v, ok := p["device_path"].(string); ok {
+			devices[i].DevicePath = v
+		}
+		if v, ok := p["name"].(string); ok {
+			devices[i].Name = v
+		}
+	}
+	return devices
+}
+
 func expandContainerEnv(in []interface{}) ([]v1.EnvVar, error) {
 	if len(in) == 0 {
 		return []v1.EnvVar{}, nil
@@ -682,6 +779,40 @@ func expandContainerEnv(in []interface{}) ([]v1.EnvVar, error) {
 	return envs, nil
 }
 
+func expandContainerEnvFrom(in []interface{}) []v1.EnvFromSource {
+	envFroms := make([]v1.EnvFromSource, len(in))
+	for i, c := range in {
+		p, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if v, ok := p["config_map_ref"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			ref, optional := expandEnvFromSourceRef(v[0].(map[string]interface{}))
+			envFroms[i].ConfigMapRef = &v1.ConfigMapEnvSource{LocalObjectReference: ref, Optional: optional}
+		}
+		if v, ok := p["prefix"].(string); ok {
+			envFroms[i].Prefix = v
+		}
+		if v, ok := p["secret_ref"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			ref, optional := expandEnvFromSourceRef(v[0].(map[string]interface{}))
+			envFroms[i].SecretRef = &v1.SecretEnvSource{LocalObjectReference: ref, Optional: optional}
+		}
+	}
+	return envFroms
+}
+
+func expandEnvFromSourceRef(in map[string]interface{}) (v1.LocalObjectReference, *bool) {
+	ref := v1.LocalObjectReference{}
+	if v, ok := in["name"].(string); ok {
+		ref.Name = v
+	}
+	var optional *bool
+	if v, ok := in["optional"].(bool); ok && v {
+		optional = ptrToBool(v)
+	}
+	return ref, optional
+}
+
 func expandContainerPort(in []interface{}) ([]v1.ContainerPort, error) {
 	if len(in) == 0 {
 		return []v1.ContainerPort{}, nil
@@ -0,0 +1,91 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/api/core/v1"
+)
+
+func TestContainerEnvFromRoundTrip(t *testing.T) {
+	cases := [][]v1.EnvFromSource{
+		{
+			{
+				ConfigMapRef: &v1.ConfigMapEnvSource{
+					LocalObjectReference: v1.LocalObjectReference{Name: "settings"},
+				},
+			},
+			{
+				Prefix: "DB_",
+				SecretRef: &v1.SecretEnvSource{
+					LocalObjectReference: v1.LocalObjectReference{Name: "credentials"},
+					Optional:             ptrToBool(true),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandContainerEnvFrom(flattenContainerEnvFroms(tc))
+		if !reflect.DeepEqual(output, tc) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", tc, output)
+		}
+	}
+}
+
+func TestExpandContainersPrivilegeEscalation(t *testing.T) {
+	podSpec := map[string]interface{}{
+		"container": []interface{}{
+			map[string]interface{}{"name": "unset", "image": "nginx"},
+			map[string]interface{}{"name": "disabled", "image": "nginx", "security_context": []interface{}{
+				map[string]interface{}{"allow_privilege_escalation": false},
+			}},
+		},
+		"init_container": []interface{}{
+			map[string]interface{}{"name": "enabled", "image": "nginx", "security_context": []interface{}{
+				map[string]interface{}{"allow_privilege_escalation": true},
+			}},
+		},
+	}
+
+	pod := schema.TestResourceDataRaw(t, resourceKubernetesPod().Schema, map[string]interface{}{
+		"spec": []interface{}{podSpec},
+	})
+	podOut, err := expandPodSpec(pod.Get("spec").([]interface{}), pod, "spec.0.")
+	if err != nil {
+		t.Fatalf("Unexpected error from expander: %s", err)
+	}
+
+	// The pod templates are nested deeper in the configuration
+	deployment := schema.TestResourceDataRaw(t, resourceKubernetesDeployment().Schema, map[string]interface{}{
+		"spec": []interface{}{map[string]interface{}{
+			"template": []interface{}{map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{}},
+				"spec":     []interface{}{podSpec},
+			}},
+		}},
+	})
+	deploymentOut := expandDeploymentSpec(deployment.Get("spec").([]interface{}), deployment, &metadataConfig{}).Template.Spec
+
+	for _, spec := range []v1.PodSpec{podOut, deploymentOut} {
+		cases := []struct {
+			Name     string
+			Context  *v1.SecurityContext
+			Expected *bool
+		}{
+			{"unset", spec.Containers[0].SecurityContext, nil},
+			{"disabled", spec.Containers[1].SecurityContext, ptrToBool(false)},
+			{"enabled", spec.InitContainers[0].SecurityContext, ptrToBool(true)},
+		}
+		for _, tc := range cases {
+			var given *bool
+			if tc.Context != nil {
+				given = tc.Context.AllowPrivilegeEscalation
+			}
+			if !reflect.DeepEqual(given, tc.Expected) {
+				t.Fatalf("Unexpected allow_privilege_escalation of %s.\nExpected: %#v\nGiven:    %#v", tc.Name, tc.Expected, given)
+			}
+		}
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func flattenCronJobSpec(in api.CronJobSpec) []interface{} {
+func flattenCronJobSpec(in api.CronJobSpec, d *schema.ResourceData, config *metadataConfig) []interface{} {
 	att := make(map[string]interface{})
 	
 	att["schedule"] = in.Schedule
@@ -23,21 +23,21 @@ func flattenCronJobSpec(in api.CronJobSpec) []interface{} {
 	if in.FailedJobsHistoryLimit != nil {
 		att["failed_jobs_history_limit"] = *in.FailedJobsHistoryLimit
 	}
-	att["job_template"] = flattenJobTemplate(in.JobTemplate)
+	att["job_template"] = flattenJobTemplate(in.JobTemplate, d, config, "spec.0.job_template.0.")
 
 	return []interface{}{att}
 }
 
-func flattenJobTemplate(in api.JobTemplateSpec) []interface{} {
+func flattenJobTemplate(in api.JobTemplateSpec, d *schema.ResourceData, config *metadataConfig, prefix string) []interface{} {
 	att := make(map[string]interface{})
 
-	att["metadata"] = flattenMetadata(in.ObjectMeta)
-	att["spec"] = flattenJobSpec(in.Spec)
+	att["metadata"] = flattenMetadata(in.ObjectMeta, d, config, prefix)
+	att["spec"] = flattenJobSpec(in.Spec, d, config, prefix+"spec.0.")
 
 	return []interface{}{att}
 }
 
-func flattenJobSpec(in batchv1.JobSpec) []interface{} {
+func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData, config *metadataConfig, prefix string) []interface{} {
 	att := make(map[string]interface{})
 
 	if in.Parallelism != nil {
@@ -55,12 +55,12 @@ func flattenJobSpec(in batchv1.JobSpec) []interface{} {
 	if in.Selector != nil {
 		att["selector"] = flattenLabelSelector(in.Selector)
 	}
-	att["template"] = flattenPodTemplateSpec(in.Template)
+	att["template"] = flattenPodTemplateSpec(in.Template, d, config, prefix+"template.0.")
 
 	return []interface{}{att}
 }
 
-func expandCronJobSpec(in []interface{}) api.CronJobSpec {
+func expandCronJobSpec(in []interface{}, d *schema.ResourceData, config *metadataConfig) api.CronJobSpec {
 	if len(in) == 0 || in[0] == nil {
 		return api.CronJobSpec{}
 	}
@@ -85,12 +85,12 @@ func expandCronJobSpec(in []interface{}) api.CronJobSpec {
 		spec.FailedJobsHistoryLimit = ptrToInt32(int32(v))
 	}
 	if v, ok := m["job_template"].([]interface{}); ok {
-		spec.JobTemplate = expandJobTemplateSpec(v)
+		spec.JobTemplate = expandJobTemplateSpec(v, d, config, "spec.0.job_template.0.")
 	}
 	return spec
 }
 
-func expandJobTemplateSpec(in []interface{}) api.JobTemplateSpec {
+func expandJobTemplateSpec(in []interface{}, d *schema.ResourceData, config *metadataConfig, prefix string) api.JobTemplateSpec {
 	if len(in) == 0 || in[0] == nil {
 		return api.JobTemplateSpec{}
 	}
@@ -98,17 +98,17 @@ func expandJobTemplateSpec(in []interface{}) api.JobTemplateSpec {
 	m := in[0].(map[string]interface{})
 
 	if v, ok := m["metadata"].([]interface{}); ok {
-		spec.ObjectMeta = expandMetadata(v)
+		spec.ObjectMeta = expandMetadata(v, config)
 	}
 
 	if v, ok := m["spec"].([]interface{}); ok {
-		spec.Spec = expandJobSpec(v)
+		spec.Spec = expandJobSpec(v, d, config, prefix+"spec.0.")
 	}
 
 	return spec
 }
 
-func expandJobSpec(in []interface{}) batchv1.JobSpec {
+func expandJobSpec(in []interface{}, d *schema.ResourceData, config *metadataConfig, prefix string) batchv1.JobSpec {
 	if len(in) == 0 || in[0] == nil {
 		return batchv1.JobSpec{}
 	}
@@ -139,7 +139,7 @@ func expandJobSpec(in []interface{}) batchv1.JobSpec {
 	spec.ManualSelector = ptrToBool(false)
 
 	if v, ok := m["template"].([]interface{}); ok {
-		spec.Template = expandPodTemplateSpec(v)
+		spec.Template = expandPodTemplateSpec(v, d, config, prefix+"template.0.")
 	}
 
 	return spec
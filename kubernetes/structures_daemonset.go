@@ -1,15 +1,16 @@
 package kubernetes
 
 import (
+	"github.com/hashicorp/terraform/helper/schema"
 	api "k8s.io/api/apps/v1"
 )
 
-func flattenDaemonsetSpec(in api.DaemonSetSpec) []interface{} {
+func flattenDaemonsetSpec(in api.DaemonSetSpec, d *schema.ResourceData, config *metadataConfig) []interface{} {
 	att := make(map[string]interface{})
 	
 	att["selector"] = flattenLabelSelector(in.Selector)
 
-	att["template"] = flattenPodTemplateSpec(in.Template)
+	att["template"] = flattenPodTemplateSpec(in.Template, d, config, "spec.0.template.0.")
 	att["update_strategy"] = flattenDaemonsetUpdateStrategy(in.UpdateStrategy)
 	if in.RevisionHistoryLimit != nil {
 		att["revision_history_limit"] = *in.RevisionHistoryLimit
@@ -30,7 +31,7 @@ func flattenDaemonsetUpdateStrategy(in api.DaemonSetUpdateStrategy) []interface{
 	return []interface{}{att}
 }
 
-func expandDaemonsetSpec(in []interface{}) api.DaemonSetSpec {
+func expandDaemonsetSpec(in []interface{}, d *schema.ResourceData, config *metadataConfig) api.DaemonSetSpec {
 	if len(in) == 0 || in[0] == nil {
 		return api.DaemonSetSpec{}
 	}
@@ -40,7 +41,7 @@ func expandDaemonsetSpec(in []interface{}) api.DaemonSetSpec {
 		spec.Selector = expandLabelSelector(v)
 	}
 	if v, ok := m["template"].([]interface{}); ok {
-		spec.Template = expandPodTemplateSpec(v)
+		spec.Template = expandPodTemplateSpec(v, d, config, "spec.0.template.0.")
 	}
 	if v, ok := m["update_strategy"].([]interface{}); ok {
 		spec.UpdateStrategy = expandDaemonSetUpdateStrategy(v)
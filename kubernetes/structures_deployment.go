@@ -6,13 +6,13 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func flattenDeploymentSpec(in api.DeploymentSpec) []interface{} {
+func flattenDeploymentSpec(in api.DeploymentSpec, d *schema.ResourceData, config *metadataConfig) []interface{} {
 	att := make(map[string]interface{})
 	if in.Replicas != nil {
 		att["replicas"] = *in.Replicas
 	}
 	att["selector"] = flattenLabelSelector(in.Selector)
-	att["template"] = flattenPodTemplateSpec(in.Template)
+	att["template"] = flattenPodTemplateSpec(in.Template, d, config, "spec.0.template.0.")
 	att["min_ready_seconds"] = in.MinReadySeconds
 	if in.ProgressDeadlineSeconds != nil {
 		att["progress_deadline_seconds"] = *in.ProgressDeadlineSeconds
@@ -43,7 +43,7 @@ func flattenDeploymentStrategy(in api.DeploymentStrategy) []interface{} {
 	return []interface{}{att}
 }
 
-func expandDeploymentSpec(in []interface{}) api.DeploymentSpec {
+func expandDeploymentSpec(in []interface{}, d *schema.ResourceData, config *metadataConfig) api.DeploymentSpec {
 	if len(in) == 0 || in[0] == nil {
 		return api.DeploymentSpec{}
 	}
@@ -56,7 +56,7 @@ func expandDeploymentSpec(in []interface{}) api.DeploymentSpec {
 		spec.Selector = expandLabelSelector(v)
 	}
 	if v, ok := m["template"].([]interface{}); ok {
-		spec.Template = expandPodTemplateSpec(v)
+		spec.Template = expandPodTemplateSpec(v, d, config, "spec.0.template.0.")
 	}
 
 	if v, ok := m["min_ready_seconds"].(int); ok {
@@ -100,7 +100,7 @@ func expandDeploymentStrategy(in []interface{}) api.DeploymentStrategy {
 	return strategy
 }
 
-func patchDeploymentSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOperations, error) {
+func patchDeploymentSpec(pathPrefix, prefix string, d *schema.ResourceData, meta interface{}) (PatchOperations, error) {
 	ops := make([]PatchOperation, 0)
 
 	if d.HasChange(prefix + "replicas") {
@@ -112,7 +112,7 @@ func patchDeploymentSpec(pathPrefix, prefix string, d *schema.ResourceData) (Pat
 	}
 
 	if d.HasChange(prefix + "template.0.metadata") {
-		metadataOps := patchMetadata(pathPrefix + "/template/metadata/", prefix + "template.0.metadata.0.", d)
+		metadataOps := patchMetadata(pathPrefix + "/template/metadata/", prefix + "template.0.metadata.0.", d, meta)
 		
 		ops = append(ops, metadataOps...)
 	}
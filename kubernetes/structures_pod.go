@@ -1,10 +1,12 @@
 package kubernetes
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Flatteners
@@ -15,6 +17,10 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 		att["active_deadline_seconds"] = *in.ActiveDeadlineSeconds
 	}
 
+	if in.Affinity != nil {
+		att["affinity"] = flattenAffinity(in.Affinity)
+	}
+
 	containers, err := flattenContainers(in.Containers)
 	if err != nil {
 		return nil, err
@@ -27,12 +33,18 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	}
 	att["init_container"] = initContainers
 
+	if in.DNSConfig != nil {
+		att["dns_config"] = flattenPodDNSConfig(in.DNSConfig)
+	}
 	att["dns_policy"] = in.DNSPolicy
 
 	att["host_ipc"] = in.HostIPC
 	att["host_network"] = in.HostNetwork
 	att["host_pid"] = in.HostPID
 
+	if len(in.HostAliases) > 0 {
+		att["host_aliases"] = flattenHostAliases(in.HostAliases)
+	}
 	if in.Hostname != "" {
 		att["hostname"] = in.Hostname
 	}
@@ -44,9 +56,15 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	if len(in.NodeSelector) > 0 {
 		att["node_selector"] = in.NodeSelector
 	}
+	if in.PriorityClassName != "" {
+		att["priority_class_name"] = in.PriorityClassName
+	}
 	if in.RestartPolicy != "" {
 		att["restart_policy"] = in.RestartPolicy
 	}
+	if in.SchedulerName != "" {
+		att["scheduler_name"] = in.SchedulerName
+	}
 
 	if in.SecurityContext != nil {
 		att["security_context"] = flattenPodSecurityContext(in.SecurityContext)
@@ -54,7 +72,7 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	if in.ServiceAccountName != "" {
 		att["service_account_name"] = in.ServiceAccountName
 	}
-	if *in.AutomountServiceAccountToken {
+	if in.AutomountServiceAccountToken != nil && *in.AutomountServiceAccountToken {
 		att["automount_service_account"] = true
 	}
 	if in.Subdomain != "" {
@@ -79,6 +97,127 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	return []interface{}{att}, nil
 }
 
+func flattenAffinity(in *v1.Affinity) []interface{} {
+	att := make(map[string]interface{})
+	if in.NodeAffinity != nil {
+		att["node_affinity"] = flattenNodeAffinity(in.NodeAffinity)
+	}
+	if in.PodAffinity != nil {
+		att["pod_affinity"] = flattenPodAffinityTerms(in.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, in.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+	if in.PodAntiAffinity != nil {
+		att["pod_anti_affinity"] = flattenPodAffinityTerms(in.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, in.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+	return []interface{}{att}
+}
+
+func flattenNodeAffinity(in *v1.NodeAffinity) []interface{} {
+	att := make(map[string]interface{})
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		terms := make([]interface{}, len(in.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms))
+		for i, t := range in.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+			terms[i] = flattenNodeSelectorTerm(t)
+		}
+		att["required_during_scheduling_ignored_during_execution"] = []interface{}{
+			map[string]interface{}{"node_selector_term": terms},
+		}
+	}
+	if len(in.PreferredDuringSchedulingIgnoredDuringExecution) > 0 {
+		preferred := make([]interface{}, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i, p := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			preferred[i] = map[string]interface{}{
+				"weight":     int(p.Weight),
+				"preference": []interface{}{flattenNodeSelectorTerm(p.Preference)},
+			}
+		}
+		att["preferred_during_scheduling_ignored_during_execution"] = preferred
+	}
+	return []interface{}{att}
+}
+
+func flattenNodeSelectorTerm(in v1.NodeSelectorTerm) map[string]interface{} {
+	att := make(map[string]interface{})
+	if len(in.MatchExpressions) > 0 {
+		exprs := make([]interface{}, len(in.MatchExpressions))
+		for i, e := range in.MatchExpressions {
+			exprs[i] = map[string]interface{}{
+				"key":      e.Key,
+				"operator": string(e.Operator),
+				"values":   newStringSet(schema.HashString, e.Values),
+			}
+		}
+		att["match_expressions"] = exprs
+	}
+	return att
+}
+
+func flattenPodAffinityTerms(required []v1.PodAffinityTerm, preferred []v1.WeightedPodAffinityTerm) []interface{} {
+	att := make(map[string]interface{})
+	if len(required) > 0 {
+		terms := make([]interface{}, len(required))
+		for i, t := range required {
+			terms[i] = flattenPodAffinityTerm(t)
+		}
+		att["required_during_scheduling_ignored_during_execution"] = terms
+	}
+	if len(preferred) > 0 {
+		terms := make([]interface{}, len(preferred))
+		for i, t := range preferred {
+			terms[i] = map[string]interface{}{
+				"weight":            int(t.Weight),
+				"pod_affinity_term": []interface{}{flattenPodAffinityTerm(t.PodAffinityTerm)},
+			}
+		}
+		att["preferred_during_scheduling_ignored_during_execution"] = terms
+	}
+	return []interface{}{att}
+}
+
+func flattenPodAffinityTerm(in v1.PodAffinityTerm) map[string]interface{} {
+	att := make(map[string]interface{})
+	if in.LabelSelector != nil {
+		att["label_selector"] = flattenLabelSelector(in.LabelSelector)
+	}
+	if len(in.Namespaces) > 0 {
+		att["namespaces"] = newStringSet(schema.HashString, in.Namespaces)
+	}
+	att["topology_key"] = in.TopologyKey
+	return att
+}
+
+func flattenPodDNSConfig(in *v1.PodDNSConfig) []interface{} {
+	att := make(map[string]interface{})
+	if len(in.Nameservers) > 0 {
+		att["nameservers"] = in.Nameservers
+	}
+	if len(in.Options) > 0 {
+		options := make([]interface{}, len(in.Options))
+		for i, o := range in.Options {
+			m := map[string]interface{}{"name": o.Name}
+			if o.Value != nil {
+				m["value"] = *o.Value
+			}
+			options[i] = m
+		}
+		att["option"] = options
+	}
+	if len(in.Searches) > 0 {
+		att["searches"] = in.Searches
+	}
+	return []interface{}{att}
+}
+
+func flattenHostAliases(in []v1.HostAlias) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		att[i] = map[string]interface{}{
+			"hostnames": v.Hostnames,
+			"ip":        v.IP,
+		}
+	}
+	return att
+}
+
 func flattenPodTolerations(tolerations []v1.Toleration) []interface{} {
 	att := make([]interface{}, len(tolerations))
 	for i, v := range tolerations {
@@ -87,7 +226,7 @@ func flattenPodTolerations(tolerations []v1.Toleration) []interface{} {
 		obj["effect"] = string(v.Effect)
 		obj["key"] = v.Key
 		obj["operator"] = string(v.Operator)
-		if(v.TolerationSeconds != nil){
+		if v.TolerationSeconds != nil {
 			obj["toleration_seconds"] = *v.TolerationSeconds
 		}
 		obj["value"] = v.Value
@@ -134,7 +273,7 @@ func flattenSeLinuxOptions(in *v1.SELinuxOptions) []interface{} {
 	if in.Role != "" {
 		att["role"] = in.Role
 	}
-	if in.User != "" {
+	if in.Type != "" {
 		att["type"] = in.Type
 	}
 	if in.Level != "" {
@@ -163,6 +302,9 @@ func flattenVolumes(volumes []v1.Volume) ([]interface{}, error) {
 		if v.DownwardAPI != nil {
 			obj["downward_api"] = flattenDownwardAPIVolumeSource(v.DownwardAPI)
 		}
+		if v.Projected != nil {
+			obj["projected"] = flattenProjectedVolumeSource(v.Projected)
+		}
 		if v.PersistentVolumeClaim != nil {
 			obj["persistent_volume_claim"] = flattenPersistentVolumeClaimVolumeSource(v.PersistentVolumeClaim)
 		}
@@ -253,7 +395,7 @@ func flattenGitRepoVolumeSource(in *v1.GitRepoVolumeSource) []interface{} {
 func flattenDownwardAPIVolumeSource(in *v1.DownwardAPIVolumeSource) []interface{} {
 	att := make(map[string]interface{})
 	if in.DefaultMode != nil {
-		att["default_mode"] = in.DefaultMode
+		att["default_mode"] = int(*in.DefaultMode)
 	}
 	if len(in.Items) > 0 {
 		att["items"] = flattenDownwardAPIVolumeFile(in.Items)
@@ -269,7 +411,7 @@ func flattenDownwardAPIVolumeFile(in []v1.DownwardAPIVolumeFile) []interface{} {
 			m["field_ref"] = flattenObjectFieldSelector(v.FieldRef)
 		}
 		if v.Mode != nil {
-			m["mode"] = *v.Mode
+			m["mode"] = int(*v.Mode)
 		}
 		if v.Path != "" {
 			m["path"] = v.Path
@@ -289,15 +431,7 @@ func flattenConfigMapVolumeSource(in *v1.ConfigMapVolumeSource) []interface{} {
 	}
 	att["name"] = in.Name
 	if len(in.Items) > 0 {
-		items := make([]interface{}, len(in.Items))
-		for i, v := range in.Items {
-			m := map[string]interface{}{}
-			m["key"] = v.Key
-			m["mode"] = *v.Mode
-			m["path"] = v.Path
-			items[i] = m
-		}
-		att["items"] = items
+		att["items"] = flattenKeyToPath(in.Items)
 	}
 
 	return []interface{}{att}
@@ -305,10 +439,66 @@ func flattenConfigMapVolumeSource(in *v1.ConfigMapVolumeSource) []interface{} {
 
 func flattenEmptyDirVolumeSource(in *v1.EmptyDirVolumeSource) []interface{} {
 	att := make(map[string]interface{})
-	att["medium"] = in.Medium
+	att["medium"] = string(in.Medium)
+	if in.SizeLimit != nil {
+		att["size_limit"] = in.SizeLimit.String()
+	}
+	return []interface{}{att}
+}
+
+func flattenProjectedVolumeSource(in *v1.ProjectedVolumeSource) []interface{} {
+	att := make(map[string]interface{})
+	if in.DefaultMode != nil {
+		att["default_mode"] = int(*in.DefaultMode)
+	}
+	sources := make([]interface{}, len(in.Sources))
+	for i, v := range in.Sources {
+		m := map[string]interface{}{}
+		if v.ConfigMap != nil {
+			m["config_map"] = flattenProjectionSource(v.ConfigMap.LocalObjectReference, v.ConfigMap.Items, v.ConfigMap.Optional)
+		}
+		if v.DownwardAPI != nil {
+			dapi := map[string]interface{}{}
+			if len(v.DownwardAPI.Items) > 0 {
+				dapi["items"] = flattenDownwardAPIVolumeFile(v.DownwardAPI.Items)
+			}
+			m["downward_api"] = []interface{}{dapi}
+		}
+		if v.Secret != nil {
+			m["secret"] = flattenProjectionSource(v.Secret.LocalObjectReference, v.Secret.Items, v.Secret.Optional)
+		}
+		sources[i] = m
+	}
+	att["sources"] = sources
+	return []interface{}{att}
+}
+
+func flattenProjectionSource(ref v1.LocalObjectReference, items []v1.KeyToPath, optional *bool) []interface{} {
+	att := make(map[string]interface{})
+	att["name"] = ref.Name
+	if len(items) > 0 {
+		att["items"] = flattenKeyToPath(items)
+	}
+	if optional != nil {
+		att["optional"] = *optional
+	}
 	return []interface{}{att}
 }
 
+func flattenKeyToPath(in []v1.KeyToPath) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		m := map[string]interface{}{}
+		m["key"] = v.Key
+		if v.Mode != nil {
+			m["mode"] = int(*v.Mode)
+		}
+		m["path"] = v.Path
+		att[i] = m
+	}
+	return att
+}
+
 func flattenSecretVolumeSource(in *v1.SecretVolumeSource) []interface{} {
 	att := make(map[string]interface{})
 	if in.DefaultMode != nil {
@@ -338,7 +528,9 @@ func flattenSecretVolumeSource(in *v1.SecretVolumeSource) []interface{} {
 
 // Expanders
 
-func expandPodSpec(p []interface{}) (v1.PodSpec, error) {
+// expandPodSpec expands the pod spec found at the given key prefix of d, e.g.
+// spec.0.
+func expandPodSpec(p []interface{}, d *schema.ResourceData, prefix string) (v1.PodSpec, error) {
 	obj := v1.PodSpec{}
 	if len(p) == 0 || p[0] == nil {
 		return obj, nil
@@ -349,8 +541,12 @@ func expandPodSpec(p []interface{}) (v1.PodSpec, error) {
 		obj.ActiveDeadlineSeconds = ptrToInt64(int64(v))
 	}
 
+	if v, ok := in["affinity"].([]interface{}); ok && len(v) > 0 {
+		obj.Affinity = expandAffinity(v)
+	}
+
 	if v, ok := in["container"].([]interface{}); ok && len(v) > 0 {
-		cs, err := expandContainers(v)
+		cs, err := expandContainers(v, d, prefix+"container")
 		if err != nil {
 			return obj, err
 		}
@@ -358,17 +554,25 @@ func expandPodSpec(p []interface{}) (v1.PodSpec, error) {
 	}
 
 	if v, ok := in["init_container"].([]interface{}); ok && len(v) > 0 {
-		cs, err := expandContainers(v)
+		cs, err := expandContainers(v, d, prefix+"init_container")
 		if err != nil {
 			return obj, err
 		}
 		obj.InitContainers = cs
 	}
 
+	if v, ok := in["dns_config"].([]interface{}); ok && len(v) > 0 {
+		obj.DNSConfig = expandPodDNSConfig(v)
+	}
+
 	if v, ok := in["dns_policy"].(string); ok {
 		obj.DNSPolicy = v1.DNSPolicy(v)
 	}
 
+	if v, ok := in["host_aliases"].([]interface{}); ok && len(v) > 0 {
+		obj.HostAliases = expandHostAliases(v)
+	}
+
 	if v, ok := in["host_ipc"]; ok {
 		obj.HostIPC = v.(bool)
 	}
@@ -404,10 +608,18 @@ func expandPodSpec(p []interface{}) (v1.PodSpec, error) {
 		obj.NodeSelector = nodeSelectors
 	}
 
+	if v, ok := in["priority_class_name"].(string); ok {
+		obj.PriorityClassName = v
+	}
+
 	if v, ok := in["restart_policy"].(string); ok {
 		obj.RestartPolicy = v1.RestartPolicy(v)
 	}
 
+	if v, ok := in["scheduler_name"].(string); ok {
+		obj.SchedulerName = v
+	}
+
 	if v, ok := in["security_context"].([]interface{}); ok && len(v) > 0 {
 		obj.SecurityContext = expandPodSecurityContext(v)
 	}
@@ -443,6 +655,165 @@ func expandPodSpec(p []interface{}) (v1.PodSpec, error) {
 	return obj, nil
 }
 
+func expandAffinity(l []interface{}) *v1.Affinity {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.Affinity{}
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.Affinity{}
+	if v, ok := in["node_affinity"].([]interface{}); ok && len(v) > 0 {
+		obj.NodeAffinity = expandNodeAffinity(v)
+	}
+	if v, ok := in["pod_affinity"].([]interface{}); ok && len(v) > 0 {
+		required, preferred := expandPodAffinityTerms(v)
+		obj.PodAffinity = &v1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  required,
+			PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+		}
+	}
+	if v, ok := in["pod_anti_affinity"].([]interface{}); ok && len(v) > 0 {
+		required, preferred := expandPodAffinityTerms(v)
+		obj.PodAntiAffinity = &v1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  required,
+			PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+		}
+	}
+	return obj
+}
+
+func expandNodeAffinity(l []interface{}) *v1.NodeAffinity {
+	obj := &v1.NodeAffinity{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	if v, ok := in["required_during_scheduling_ignored_during_execution"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		terms := v[0].(map[string]interface{})["node_selector_term"].([]interface{})
+		obj.RequiredDuringSchedulingIgnoredDuringExecution = &v1.NodeSelector{
+			NodeSelectorTerms: make([]v1.NodeSelectorTerm, len(terms)),
+		}
+		for i, t := range terms {
+			obj.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[i] = expandNodeSelectorTerm(t)
+		}
+	}
+	if v, ok := in["preferred_during_scheduling_ignored_during_execution"].([]interface{}); ok && len(v) > 0 {
+		obj.PreferredDuringSchedulingIgnoredDuringExecution = make([]v1.PreferredSchedulingTerm, len(v))
+		for i, p := range v {
+			m := p.(map[string]interface{})
+			obj.PreferredDuringSchedulingIgnoredDuringExecution[i].Weight = int32(m["weight"].(int))
+			if pref, ok := m["preference"].([]interface{}); ok && len(pref) > 0 {
+				obj.PreferredDuringSchedulingIgnoredDuringExecution[i].Preference = expandNodeSelectorTerm(pref[0])
+			}
+		}
+	}
+	return obj
+}
+
+func expandNodeSelectorTerm(t interface{}) v1.NodeSelectorTerm {
+	obj := v1.NodeSelectorTerm{}
+	in, ok := t.(map[string]interface{})
+	if !ok {
+		return obj
+	}
+	if v, ok := in["match_expressions"].([]interface{}); ok && len(v) > 0 {
+		obj.MatchExpressions = make([]v1.NodeSelectorRequirement, len(v))
+		for i, e := range v {
+			m := e.(map[string]interface{})
+			obj.MatchExpressions[i] = v1.NodeSelectorRequirement{
+				Key:      m["key"].(string),
+				Operator: v1.NodeSelectorOperator(m["operator"].(string)),
+				Values:   sliceOfString(m["values"].(*schema.Set).List()),
+			}
+		}
+	}
+	return obj
+}
+
+func expandPodAffinityTerms(l []interface{}) ([]v1.PodAffinityTerm, []v1.WeightedPodAffinityTerm) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	var required []v1.PodAffinityTerm
+	if v, ok := in["required_during_scheduling_ignored_during_execution"].([]interface{}); ok && len(v) > 0 {
+		required = make([]v1.PodAffinityTerm, len(v))
+		for i, t := range v {
+			required[i] = expandPodAffinityTerm(t)
+		}
+	}
+
+	var preferred []v1.WeightedPodAffinityTerm
+	if v, ok := in["preferred_during_scheduling_ignored_during_execution"].([]interface{}); ok && len(v) > 0 {
+		preferred = make([]v1.WeightedPodAffinityTerm, len(v))
+		for i, t := range v {
+			m := t.(map[string]interface{})
+			preferred[i].Weight = int32(m["weight"].(int))
+			if term, ok := m["pod_affinity_term"].([]interface{}); ok && len(term) > 0 {
+				preferred[i].PodAffinityTerm = expandPodAffinityTerm(term[0])
+			}
+		}
+	}
+	return required, preferred
+}
+
+func expandPodAffinityTerm(t interface{}) v1.PodAffinityTerm {
+	obj := v1.PodAffinityTerm{}
+	in, ok := t.(map[string]interface{})
+	if !ok {
+		return obj
+	}
+	if v, ok := in["label_selector"].([]interface{}); ok && len(v) > 0 {
+		obj.LabelSelector = expandLabelSelector(v)
+	}
+	if v, ok := in["namespaces"].(*schema.Set); ok && v.Len() > 0 {
+		obj.Namespaces = sliceOfString(v.List())
+	}
+	if v, ok := in["topology_key"].(string); ok {
+		obj.TopologyKey = v
+	}
+	return obj
+}
+
+func expandPodDNSConfig(l []interface{}) *v1.PodDNSConfig {
+	obj := &v1.PodDNSConfig{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	if v, ok := in["nameservers"].([]interface{}); ok && len(v) > 0 {
+		obj.Nameservers = expandStringSlice(v)
+	}
+	if v, ok := in["option"].([]interface{}); ok && len(v) > 0 {
+		obj.Options = make([]v1.PodDNSConfigOption, len(v))
+		for i, o := range v {
+			m := o.(map[string]interface{})
+			obj.Options[i].Name = m["name"].(string)
+			if value, ok := m["value"].(string); ok && value != "" {
+				obj.Options[i].Value = &value
+			}
+		}
+	}
+	if v, ok := in["searches"].([]interface{}); ok && len(v) > 0 {
+		obj.Searches = expandStringSlice(v)
+	}
+	return obj
+}
+
+func expandHostAliases(in []interface{}) []v1.HostAlias {
+	aliases := make([]v1.HostAlias, len(in))
+	for i, c := range in {
+		p := c.(map[string]interface{})
+		if v, ok := p["hostnames"].([]interface{}); ok {
+			aliases[i].Hostnames = expandStringSlice(v)
+		}
+		if v, ok := p["ip"].(string); ok {
+			aliases[i].IP = v
+		}
+	}
+	return aliases
+}
+
 func expandTolerations(in []interface{}) []v1.Toleration {
 	if len(in) == 0 {
 		return []v1.Toleration{}
@@ -459,7 +830,7 @@ func expandTolerations(in []interface{}) []v1.Toleration {
 		if v, ok := p["operator"].(string); ok {
 			tolerations[i].Operator = v1.TolerationOperator(v)
 		}
-		if v, ok := p["toleration_seconds"].(int); ok && v >=0 {
+		if v, ok := p["toleration_seconds"].(int); ok && v >= 0 {
 			tolerations[i].TolerationSeconds = ptrToInt64(int64(v))
 		}
 		if v, ok := p["value"].(string); ok {
@@ -527,7 +898,7 @@ func expandKeyPath(in []interface{}) []v1.KeyToPath {
 		if v, ok := p["key"].(string); ok {
 			keyPaths[i].Key = v
 		}
-		if v, ok := p["mode"].(int); ok {
+		if v, ok := p["mode"].(int); ok && v > 0 {
 			keyPaths[i].Mode = ptrToInt32(int32(v))
 		}
 		if v, ok := p["path"].(string); ok {
@@ -546,7 +917,7 @@ func expandDownwardAPIVolumeFile(in []interface{}) ([]v1.DownwardAPIVolumeFile,
 	dapivf := make([]v1.DownwardAPIVolumeFile, len(in))
 	for i, c := range in {
 		p := c.(map[string]interface{})
-		if v, ok := p["mode"].(int); ok {
+		if v, ok := p["mode"].(int); ok && v > 0 {
 			dapivf[i].Mode = ptrToInt32(int32(v))
 		}
 		if v, ok := p["path"].(string); ok {
@@ -626,15 +997,86 @@ func expandGitRepoVolumeSource(l []interface{}) *v1.GitRepoVolumeSource {
 	return obj
 }
 
-func expandEmptyDirVolumeSource(l []interface{}) *v1.EmptyDirVolumeSource {
+func expandEmptyDirVolumeSource(l []interface{}) (*v1.EmptyDirVolumeSource, error) {
 	if len(l) == 0 || l[0] == nil {
-		return &v1.EmptyDirVolumeSource{}
+		return &v1.EmptyDirVolumeSource{}, nil
 	}
 	in := l[0].(map[string]interface{})
 	obj := &v1.EmptyDirVolumeSource{
 		Medium: v1.StorageMedium(in["medium"].(string)),
 	}
-	return obj
+	if v, ok := in["size_limit"].(string); ok && v != "" {
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return obj, fmt.Errorf("%s for %q", err, v)
+		}
+		obj.SizeLimit = &q
+	}
+	return obj, nil
+}
+
+func expandProjectedVolumeSource(l []interface{}) (*v1.ProjectedVolumeSource, error) {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.ProjectedVolumeSource{}, nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.ProjectedVolumeSource{}
+	if v, ok := in["default_mode"].(int); ok {
+		obj.DefaultMode = ptrToInt32(int32(v))
+	}
+	sources, _ := in["sources"].([]interface{})
+	obj.Sources = make([]v1.VolumeProjection, len(sources))
+	for i, s := range sources {
+		m, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if v, ok := m["config_map"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			ref, items, optional := expandProjectionSource(v[0].(map[string]interface{}))
+			obj.Sources[i].ConfigMap = &v1.ConfigMapProjection{
+				LocalObjectReference: ref,
+				Items:                items,
+				Optional:             optional,
+			}
+		}
+		if v, ok := m["downward_api"].([]interface{}); ok && len(v) > 0 {
+			obj.Sources[i].DownwardAPI = &v1.DownwardAPIProjection{}
+			if v[0] != nil {
+				if items, ok := v[0].(map[string]interface{})["items"].([]interface{}); ok && len(items) > 0 {
+					var err error
+					obj.Sources[i].DownwardAPI.Items, err = expandDownwardAPIVolumeFile(items)
+					if err != nil {
+						return obj, err
+					}
+				}
+			}
+		}
+		if v, ok := m["secret"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			ref, items, optional := expandProjectionSource(v[0].(map[string]interface{}))
+			obj.Sources[i].Secret = &v1.SecretProjection{
+				LocalObjectReference: ref,
+				Items:                items,
+				Optional:             optional,
+			}
+		}
+	}
+	return obj, nil
+}
+
+func expandProjectionSource(in map[string]interface{}) (v1.LocalObjectReference, []v1.KeyToPath, *bool) {
+	ref := v1.LocalObjectReference{}
+	if v, ok := in["name"].(string); ok {
+		ref.Name = v
+	}
+	var items []v1.KeyToPath
+	if v, ok := in["items"].([]interface{}); ok && len(v) > 0 {
+		items = expandKeyPath(v)
+	}
+	var optional *bool
+	if v, ok := in["optional"].(bool); ok && v {
+		optional = ptrToBool(v)
+	}
+	return ref, items, optional
 }
 
 func expandPersistentVolumeClaimVolumeSource(l []interface{}) *v1.PersistentVolumeClaimVolumeSource {
@@ -687,7 +1129,11 @@ func expandVolumes(volumes []interface{}) ([]v1.Volume, error) {
 		}
 
 		if value, ok := m["empty_dir"].([]interface{}); ok && len(value) > 0 {
-			vl[i].EmptyDir = expandEmptyDirVolumeSource(value)
+			var err error
+			vl[i].EmptyDir, err = expandEmptyDirVolumeSource(value)
+			if err != nil {
+				return vl, err
+			}
 		}
 		if value, ok := m["downward_api"].([]interface{}); ok && len(value) > 0 {
 			var err error
@@ -697,6 +1143,14 @@ func expandVolumes(volumes []interface{}) ([]v1.Volume, error) {
 			}
 		}
 
+		if value, ok := m["projected"].([]interface{}); ok && len(value) > 0 {
+			var err error
+			vl[i].Projected, err = expandProjectedVolumeSource(value)
+			if err != nil {
+				return vl, err
+			}
+		}
+
 		if value, ok := m["persistent_volume_claim"].([]interface{}); ok && len(value) > 0 {
 			vl[i].PersistentVolumeClaim = expandPersistentVolumeClaimVolumeSource(value)
 		}
@@ -779,7 +1233,7 @@ func patchPodSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOpera
 
 	if d.HasChange(prefix + "container") {
 		containers := d.Get(prefix + "container").([]interface{})
-		value, _ := expandContainers(containers)
+		value, _ := expandContainers(containers, d, prefix+"container")
 
 		for i, v := range value {
 			ops = append(ops, &ReplaceOperation{
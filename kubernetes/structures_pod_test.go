@@ -0,0 +1,251 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAffinityRoundTrip(t *testing.T) {
+	cases := []*v1.Affinity{
+		{
+			NodeAffinity: &v1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+					NodeSelectorTerms: []v1.NodeSelectorTerm{
+						{
+							MatchExpressions: []v1.NodeSelectorRequirement{
+								{Key: "kubernetes.io/os", Operator: v1.NodeSelectorOpIn, Values: []string{"linux"}},
+								{Key: "dedicated", Operator: v1.NodeSelectorOpDoesNotExist, Values: []string{}},
+							},
+						},
+					},
+				},
+				PreferredDuringSchedulingIgnoredDuringExecution: []v1.PreferredSchedulingTerm{
+					{
+						Weight: 50,
+						Preference: v1.NodeSelectorTerm{
+							MatchExpressions: []v1.NodeSelectorRequirement{
+								{Key: "cores", Operator: v1.NodeSelectorOpGt, Values: []string{"4"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			PodAffinity: &v1.PodAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+					{
+						LabelSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"cache"}},
+							},
+						},
+						Namespaces:  []string{"backend"},
+						TopologyKey: "kubernetes.io/hostname",
+					},
+				},
+			},
+			PodAntiAffinity: &v1.PodAntiAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []v1.WeightedPodAffinityTerm{
+					{
+						Weight: 100,
+						PodAffinityTerm: v1.PodAffinityTerm{
+							LabelSelector: &metav1.LabelSelector{
+								MatchExpressions: []metav1.LabelSelectorRequirement{
+									{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"web"}},
+								},
+							},
+							TopologyKey: "failure-domain.beta.kubernetes.io/zone",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandAffinity(flattenAffinity(tc))
+		if !reflect.DeepEqual(output, tc) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", tc, output)
+		}
+	}
+}
+
+func TestVolumesRoundTrip(t *testing.T) {
+	sizeLimit := resource.MustParse("1Gi")
+
+	cases := [][]v1.Volume{
+		{
+			{
+				Name: "scratch",
+				VolumeSource: v1.VolumeSource{
+					EmptyDir: &v1.EmptyDirVolumeSource{Medium: v1.StorageMediumMemory, SizeLimit: &sizeLimit},
+				},
+			},
+		},
+		{
+			{
+				Name: "podinfo",
+				VolumeSource: v1.VolumeSource{
+					DownwardAPI: &v1.DownwardAPIVolumeSource{
+						DefaultMode: ptrToInt32(0644),
+						Items: []v1.DownwardAPIVolumeFile{
+							{
+								Path:     "labels",
+								FieldRef: &v1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.labels"},
+							},
+							{
+								Path:             "cpu_limit",
+								Mode:             ptrToInt32(0400),
+								ResourceFieldRef: &v1.ResourceFieldSelector{ContainerName: "app", Resource: "limits.cpu"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			{
+				Name: "all-in-one",
+				VolumeSource: v1.VolumeSource{
+					Projected: &v1.ProjectedVolumeSource{
+						DefaultMode: ptrToInt32(0440),
+						Sources: []v1.VolumeProjection{
+							{
+								ConfigMap: &v1.ConfigMapProjection{
+									LocalObjectReference: v1.LocalObjectReference{Name: "config"},
+									Items:                []v1.KeyToPath{{Key: "app.yaml", Path: "app.yaml"}},
+								},
+							},
+							{
+								Secret: &v1.SecretProjection{
+									LocalObjectReference: v1.LocalObjectReference{Name: "tls"},
+									Items:                []v1.KeyToPath{{Key: "tls.key", Path: "tls/key.pem", Mode: ptrToInt32(0400)}},
+									Optional:             ptrToBool(true),
+								},
+							},
+							{
+								DownwardAPI: &v1.DownwardAPIProjection{
+									Items: []v1.DownwardAPIVolumeFile{
+										{
+											Path:     "name",
+											FieldRef: &v1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.name"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		flattened, err := flattenVolumes(tc)
+		if err != nil {
+			t.Fatalf("Unexpected error from flattener: %s", err)
+		}
+		output, err := expandVolumes(flattened)
+		if err != nil {
+			t.Fatalf("Unexpected error from expander: %s", err)
+		}
+		if !reflect.DeepEqual(output, tc) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", tc, output)
+		}
+	}
+}
+
+func TestPodSpecRoundTrip(t *testing.T) {
+	ndots := "2"
+	propagation := v1.MountPropagationHostToContainer
+	in := v1.PodSpec{
+		Containers: []v1.Container{
+			{
+				Name:                     "app",
+				Image:                    "nginx:1.15",
+				TerminationMessagePath:   "/dev/termination-log",
+				TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+				SecurityContext: &v1.SecurityContext{
+					AllowPrivilegeEscalation: ptrToBool(false),
+				},
+				VolumeDevices: []v1.VolumeDevice{
+					{Name: "block", DevicePath: "/dev/xvda"},
+				},
+				VolumeMounts: []v1.VolumeMount{
+					{Name: "shared", MountPath: "/shared", MountPropagation: &propagation},
+				},
+				Env: []v1.EnvVar{
+					{
+						Name: "HOST_IP",
+						ValueFrom: &v1.EnvVarSource{
+							FieldRef: &v1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "status.hostIP"},
+						},
+					},
+				},
+			},
+		},
+		DNSPolicy: v1.DNSNone,
+		DNSConfig: &v1.PodDNSConfig{
+			Nameservers: []string{"10.0.0.10"},
+			Searches:    []string{"svc.cluster.local"},
+			Options: []v1.PodDNSConfigOption{
+				{Name: "ndots", Value: &ndots},
+				{Name: "edns0"},
+			},
+		},
+		HostAliases: []v1.HostAlias{
+			{IP: "127.0.0.1", Hostnames: []string{"foo.local", "bar.local"}},
+		},
+		PriorityClassName: "high-priority",
+		RestartPolicy:     v1.RestartPolicyAlways,
+		SchedulerName:     "custom-scheduler",
+	}
+
+	flattened, err := flattenPodSpec(in)
+	if err != nil {
+		t.Fatalf("Unexpected error from flattener: %s", err)
+	}
+
+	d := testStateData(t, map[string]*schema.Schema{
+		"spec": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: podSpecFields(true),
+			},
+		},
+	}, "spec", flattened)
+
+	out, err := expandPodSpec(d.Get("spec").([]interface{}), d, "spec.0.")
+	if err != nil {
+		t.Fatalf("Unexpected error from expander: %s", err)
+	}
+
+	checks := []struct {
+		Name     string
+		Expected interface{}
+		Given    interface{}
+	}{
+		{"dns_policy", in.DNSPolicy, out.DNSPolicy},
+		{"dns_config", in.DNSConfig, out.DNSConfig},
+		{"host_aliases", in.HostAliases, out.HostAliases},
+		{"priority_class_name", in.PriorityClassName, out.PriorityClassName},
+		{"scheduler_name", in.SchedulerName, out.SchedulerName},
+		{"container.0.env", in.Containers[0].Env, out.Containers[0].Env},
+		{"container.0.security_context", in.Containers[0].SecurityContext.AllowPrivilegeEscalation, out.Containers[0].SecurityContext.AllowPrivilegeEscalation},
+		{"container.0.termination_message_policy", in.Containers[0].TerminationMessagePolicy, out.Containers[0].TerminationMessagePolicy},
+		{"container.0.volume_device", in.Containers[0].VolumeDevices, out.Containers[0].VolumeDevices},
+		{"container.0.volume_mount", in.Containers[0].VolumeMounts, out.Containers[0].VolumeMounts},
+	}
+	for _, c := range checks {
+		if !reflect.DeepEqual(c.Expected, c.Given) {
+			t.Fatalf("Unexpected %s after round trip.\nExpected: %#v\nGiven:    %#v", c.Name, c.Expected, c.Given)
+		}
+	}
+}
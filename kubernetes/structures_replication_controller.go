@@ -1,6 +1,7 @@
 package kubernetes
 
 import (
+	"github.com/hashicorp/terraform/helper/schema"
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -23,7 +24,7 @@ func flattenReplicationControllerSpec(in v1.ReplicationControllerSpec) ([]interf
 	return []interface{}{att}, nil
 }
 
-func expandReplicationControllerSpec(rc []interface{}) (v1.ReplicationControllerSpec, error) {
+func expandReplicationControllerSpec(rc []interface{}, d *schema.ResourceData) (v1.ReplicationControllerSpec, error) {
 	obj := v1.ReplicationControllerSpec{}
 	if len(rc) == 0 || rc[0] == nil {
 		return obj, nil
@@ -32,7 +33,7 @@ func expandReplicationControllerSpec(rc []interface{}) (v1.ReplicationController
 	obj.MinReadySeconds = int32(in["min_ready_seconds"].(int))
 	obj.Replicas = ptrToInt32(int32(in["replicas"].(int)))
 	obj.Selector = expandStringMap(in["selector"].(map[string]interface{}))
-	podSpec, err := expandPodSpec(in["template"].([]interface{}))
+	podSpec, err := expandPodSpec(in["template"].([]interface{}), d, "spec.0.template.0.")
 	if err != nil {
 		return obj, err
 	}
@@ -1,12 +1,14 @@
 package kubernetes
 
 import (
+	"fmt"
+
 	"k8s.io/api/core/v1"
 	api "k8s.io/api/apps/v1"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func flattenStatefulsetSpec(in api.StatefulSetSpec) []interface{} {
+func flattenStatefulsetSpec(in api.StatefulSetSpec, d *schema.ResourceData, config *metadataConfig) []interface{} {
 	att := make(map[string]interface{})
 	
 	if in.Replicas != nil {
@@ -15,10 +17,10 @@ func flattenStatefulsetSpec(in api.StatefulSetSpec) []interface{} {
 
 	att["selector"] = flattenLabelSelector(in.Selector)
 
-	att["template"] = flattenPodTemplateSpec(in.Template)
+	att["template"] = flattenPodTemplateSpec(in.Template, d, config, "spec.0.template.0.")
 
 	if(in.VolumeClaimTemplates != nil && len(in.VolumeClaimTemplates) > 0){
-		att["volume_claim_template"] = flattenVolumeClaimTemplates(in.VolumeClaimTemplates)
+		att["volume_claim_template"] = flattenVolumeClaimTemplates(in.VolumeClaimTemplates, d, config)
 	}
 
 	att["service_name"] = in.ServiceName
@@ -31,21 +33,21 @@ func flattenStatefulsetSpec(in api.StatefulSetSpec) []interface{} {
 	return []interface{}{att}
 }
 
-func flattenPodTemplateSpec(in v1.PodTemplateSpec) []interface{} {
+func flattenPodTemplateSpec(in v1.PodTemplateSpec, d *schema.ResourceData, config *metadataConfig, prefix string) []interface{} {
 	att := make(map[string]interface{})
-	att["metadata"] = flattenMetadata(in.ObjectMeta)
+	att["metadata"] = flattenMetadata(in.ObjectMeta, d, config, prefix)
 	att["spec"], _ = flattenPodSpec(in.Spec)
 	return []interface{}{att}
 }
 
-func flattenVolumeClaimTemplates(in []v1.PersistentVolumeClaim) []interface{} {
+func flattenVolumeClaimTemplates(in []v1.PersistentVolumeClaim, d *schema.ResourceData, config *metadataConfig) []interface{} {
 	if in == nil {
 		return []interface{}{}
 	}
 	att := make([]interface{}, len(in), len(in))
 	for i, n := range in {
 		m := make(map[string]interface{})
-		m["metadata"] = flattenMetadata(n.ObjectMeta)
+		m["metadata"] = flattenMetadata(n.ObjectMeta, d, config, fmt.Sprintf("spec.0.volume_claim_template.%d.", i))
 		m["spec"] = flattenPersistentVolumeClaimSpec(n.Spec)
 		att[i] = m
 	}
@@ -65,7 +67,7 @@ func flattenStatefulsetUpdateStrategy(in api.StatefulSetUpdateStrategy) []interf
 	return []interface{}{att}
 }
 
-func expandStatefulsetSpec(in []interface{}) api.StatefulSetSpec {
+func expandStatefulsetSpec(in []interface{}, d *schema.ResourceData, config *metadataConfig) api.StatefulSetSpec {
 	if len(in) == 0 || in[0] == nil {
 		return api.StatefulSetSpec{}
 	}
@@ -78,10 +80,10 @@ func expandStatefulsetSpec(in []interface{}) api.StatefulSetSpec {
 		spec.Selector = expandLabelSelector(v)
 	}
 	if v, ok := m["template"].([]interface{}); ok {
-		spec.Template = expandPodTemplateSpec(v)
+		spec.Template = expandPodTemplateSpec(v, d, config, "spec.0.template.0.")
 	}
 	if v, ok := m["volume_claim_template"].([]interface{}); ok {
-		spec.VolumeClaimTemplates = expandVolumeClaimTemplate(v)
+		spec.VolumeClaimTemplates = expandVolumeClaimTemplate(v, config)
 	}
 	if v, ok := m["service_name"].(string); ok {
 		spec.ServiceName = v
@@ -98,23 +100,25 @@ func expandStatefulsetSpec(in []interface{}) api.StatefulSetSpec {
 	return spec
 }
 
-func expandPodTemplateSpec(in []interface{}) v1.PodTemplateSpec {
+// expandPodTemplateSpec expands the pod template found at the given key prefix
+// of d, e.g. spec.0.template.0.
+func expandPodTemplateSpec(in []interface{}, d *schema.ResourceData, config *metadataConfig, prefix string) v1.PodTemplateSpec {
 	if len(in) == 0 || in[0] == nil {
 		return v1.PodTemplateSpec{}
 	}
 	spec := v1.PodTemplateSpec{}
 	m := in[0].(map[string]interface{})
 	if v, ok := m["metadata"].([]interface{}); ok {
-		spec.ObjectMeta = expandMetadata(v)
+		spec.ObjectMeta = expandMetadata(v, config)
 	}
 	if v, ok := m["spec"].([]interface{}); ok {
-		spec.Spec, _ = expandPodSpec(v)
+		spec.Spec, _ = expandPodSpec(v, d, prefix+"spec.0.")
 	}
 
 	return spec
 }
 
-func expandVolumeClaimTemplate(in []interface{}) []v1.PersistentVolumeClaim {
+func expandVolumeClaimTemplate(in []interface{}, config *metadataConfig) []v1.PersistentVolumeClaim {
 	if len(in) == 0 || in[0] == nil {
 		return []v1.PersistentVolumeClaim{}
 	}
@@ -123,7 +127,7 @@ func expandVolumeClaimTemplate(in []interface{}) []v1.PersistentVolumeClaim {
 		claim := v1.PersistentVolumeClaim{}
 		m := in[i].(map[string]interface{})
 		if v, ok := m["metadata"].([]interface{}); ok {
-			claim.ObjectMeta = expandMetadata(v)
+			claim.ObjectMeta = expandMetadata(v, config)
 		}
 		if v, ok := m["spec"].([]interface{}); ok {
 			claim.Spec, _ = expandPersistentVolumeClaimSpec(v)
@@ -157,7 +161,7 @@ func expandStatefulSetUpdateStrategy(in []interface{}) api.StatefulSetUpdateStra
 	return strategy
 }
 
-func patchStatefultsetSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOperations, error) {
+func patchStatefultsetSpec(pathPrefix, prefix string, d *schema.ResourceData, meta interface{}) (PatchOperations, error) {
 	ops := make([]PatchOperation, 0)
 
 	if d.HasChange(prefix + "replicas") {
@@ -169,7 +173,7 @@ func patchStatefultsetSpec(pathPrefix, prefix string, d *schema.ResourceData) (P
 	}
 
 	if d.HasChange(prefix + "template.0.metadata") {
-		metadataOps := patchMetadata(pathPrefix + "/template/metadata/", prefix + "template.0.metadata.0.", d)
+		metadataOps := patchMetadata(pathPrefix + "/template/metadata/", prefix + "template.0.metadata.0.", d, meta)
 		
 		ops = append(ops, metadataOps...)
 	}
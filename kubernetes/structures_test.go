@@ -3,6 +3,8 @@ package kubernetes
 import (
 	"fmt"
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestIsInternalKey(t *testing.T) {
@@ -30,3 +32,14 @@ func TestIsInternalKey(t *testing.T) {
 		})
 	}
 }
+
+// testStateData stores the flattened value at the given key of a resource
+// with the given schema, so that reading it back gives the same types as the
+// expanders receive from a configuration
+func testStateData(t *testing.T, s map[string]*schema.Schema, key string, flattened interface{}) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+	if err := d.Set(key, flattened); err != nil {
+		t.Fatalf("Failed to set the flattened %s: %s", key, err)
+	}
+	return d
+}
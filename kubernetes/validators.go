@@ -1,14 +1,20 @@
 package kubernetes
 
 import (
+	"encoding/pem"
 	"fmt"
+	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	apiValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	utilValidation "k8s.io/apimachinery/pkg/util/validation"
 )
 
@@ -70,6 +76,55 @@ func validateLabels(value interface{}, key string) (ws []string, es []error) {
 	return
 }
 
+func validateLabelSelector(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if _, err := labels.Parse(v); err != nil {
+		es = append(es, fmt.Errorf("%s (%q) is not a valid label selector: %s", key, v, err))
+	}
+	return
+}
+
+func validateFieldSelector(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if _, err := fields.ParseSelector(v); err != nil {
+		es = append(es, fmt.Errorf("%s (%q) is not a valid field selector: %s", key, v, err))
+	}
+	return
+}
+
+func validateRegexp(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if _, err := regexp.Compile(v); err != nil {
+		es = append(es, fmt.Errorf("%s (%q) is not a valid regular expression: %s", key, v, err))
+	}
+	return
+}
+
+func validateWebhookURL(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	u, err := url.Parse(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("%s (%q) is not a valid URL: %s", key, v, err))
+		return
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		es = append(es, fmt.Errorf("%s (%q) must be an absolute https:// URL", key, v))
+	}
+	if u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		es = append(es, fmt.Errorf("%s (%q) must not contain user info, a query or a fragment", key, v))
+	}
+	return
+}
+
+func validateCertificateRequest(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	block, _ := pem.Decode([]byte(v))
+	if block == nil || (block.Type != "CERTIFICATE REQUEST" && block.Type != "NEW CERTIFICATE REQUEST") {
+		es = append(es, fmt.Errorf("%s must be a PEM encoded certificate request", key))
+	}
+	return
+}
+
 func validatePortNum(value interface{}, key string) (ws []string, es []error) {
 	errors := utilValidation.IsValidPortNum(value.(int))
 	if len(errors) > 0 {
@@ -145,10 +200,62 @@ func validatePositiveInteger(value interface{}, key string) (ws []string, es []e
 	return
 }
 
+func validatePositiveFloat(value interface{}, key string) (ws []string, es []error) {
+	v := value.(float64)
+	if v <= 0 {
+		es = append(es, fmt.Errorf("%s must be greater than 0", key))
+	}
+	return
+}
+
+func validateDuration(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("%s (%q) is not a valid duration: %s", key, v, err))
+		return
+	}
+	if d <= 0 {
+		es = append(es, fmt.Errorf("%s (%q) must be greater than 0", key, v))
+	}
+	return
+}
+
+func validateIntOrPercent(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if strings.HasSuffix(v, "%") {
+		v = strings.TrimSuffix(v, "%")
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil || i < 0 {
+		es = append(es, fmt.Errorf("%s (%q) must be a non-negative integer or a percentage, e.g. 2 or 50%%", key, value))
+	}
+	return
+}
+
+func validateAffinityWeight(value interface{}, key string) (ws []string, es []error) {
+	v := value.(int)
+	if v < 1 || v > 100 {
+		es = append(es, fmt.Errorf("%s must be in the range 1-100", key))
+	}
+	return
+}
+
+func validateSessionAffinityTimeout(value interface{}, key string) (ws []string, es []error) {
+	v := value.(int)
+	if v < 1 || v > 86400 {
+		es = append(es, fmt.Errorf("%s must be in the range 1-86400", key))
+	}
+	return
+}
+
 func validateDNSPolicy(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
-	if v != "ClusterFirst" && v != "Default" {
-		es = append(es, fmt.Errorf("%s must be either ClusterFirst or Default", key))
+	switch v {
+	case "ClusterFirstWithHostNet", "ClusterFirst", "Default", "None":
+		return
+	default:
+		es = append(es, fmt.Errorf("%s must be one of ClusterFirstWithHostNet, ClusterFirst, Default or None", key))
 	}
 	return
 }